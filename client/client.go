@@ -0,0 +1,232 @@
+// Package client runs the IBM Connect:Direct CLI and parses its output.
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/moov-io/go-connect-direct/command"
+	"github.com/moov-io/go-connect-direct/parser"
+)
+
+type Config struct {
+	// BinaryPath is the location of the Connect:Direct CLI. Defaults to "direct" found on $PATH.
+	BinaryPath string
+
+	// Args are extra arguments passed to the CLI
+	Args []string
+
+	// APIConfig sets NDMAPICFG, the path of the CLI's ndmapi.cfg file.
+	APIConfig string
+
+	// Env contains additional environment variables in "KEY=value" form.
+	Env []string
+
+	// Timeout limits how long each invocation of the CLI can run. Zero means no limit beyond the context.
+	Timeout time.Duration
+}
+
+type Client struct {
+	cfg Config
+}
+
+// CommandError is returned when Connect:Direct reports that a command did not complete successfully.
+type CommandError struct {
+	Command string
+	Status  string
+}
+
+func (e *CommandError) Error() string {
+	if e.Status == "" {
+		return fmt.Sprintf("%q did not report a status", e.Command)
+	}
+	return fmt.Sprintf("%q failed: %s", e.Command, e.Status)
+}
+
+const (
+	defaultBinary = "direct"
+	prompt        = "Direct>"
+	quit          = "quit;"
+)
+
+func New(cfg Config) (*Client, error) {
+	if cfg.BinaryPath == "" {
+		cfg.BinaryPath = defaultBinary
+	}
+	if cfg.Timeout < 0 {
+		return nil, fmt.Errorf("invalid timeout %v", cfg.Timeout)
+	}
+	return &Client{
+		cfg: cfg,
+	}, nil
+}
+
+// Run starts the CLI, writes each command on stdin followed by "quit;" and parses the output
+// printed after each "Direct>" prompt.
+//
+// Commands are returned in the order they were given. An error is only returned when the CLI
+// could not be run or its output could not be parsed, use Command.Succeeded to check each command.
+func (c *Client) Run(ctx context.Context, commands ...string) (parser.Transcript, error) {
+	if len(commands) == 0 {
+		return parser.Transcript{}, errors.New("no commands given")
+	}
+	for _, cmd := range commands {
+		if strings.Contains(cmd, "\n") || !strings.HasSuffix(strings.TrimSpace(cmd), ";") {
+			return parser.Transcript{}, fmt.Errorf("command must be one line ending with a semicolon: %q", cmd)
+		}
+	}
+
+	if c.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.Timeout)
+		defer cancel()
+	}
+
+	var stdin bytes.Buffer
+	for _, cmd := range commands {
+		stdin.WriteString(strings.TrimSpace(cmd) + "\n")
+	}
+	stdin.WriteString(quit + "\n")
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.cfg.BinaryPath, c.cfg.Args...)
+	cmd.Stdin = &stdin
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.WaitDelay = time.Second
+
+	cmd.Env = os.Environ()
+	if c.cfg.APIConfig != "" {
+		cmd.Env = append(cmd.Env, "NDMAPICFG="+c.cfg.APIConfig)
+	}
+	cmd.Env = append(cmd.Env, c.cfg.Env...)
+
+	err := cmd.Run()
+	if ctx.Err() != nil {
+		return parser.Transcript{}, fmt.Errorf("running %s: %w", c.cfg.BinaryPath, ctx.Err())
+	}
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return parser.Transcript{}, fmt.Errorf("running %s: %w: %s", c.cfg.BinaryPath, err, msg)
+		}
+		return parser.Transcript{}, fmt.Errorf("running %s: %w", c.cfg.BinaryPath, err)
+	}
+
+	transcript, err := parser.ParseTranscript(buildTranscript(commands, stdout.String()))
+	if err != nil {
+		return transcript, err
+	}
+	if len(transcript.Commands) < len(commands) {
+		return transcript, fmt.Errorf("found output for %d of %d commands", len(transcript.Commands), len(commands))
+	}
+	return transcript, nil
+}
+
+// buildTranscript pairs the output following each prompt with the command which was sent.
+// The CLI does not always echo commands read from stdin, so the command text is written
+// after each prompt.
+func buildTranscript(commands []string, stdout string) string {
+	segments := splitPrompts(stdout)
+
+	var buf strings.Builder
+	for i, cmd := range commands {
+		if i+1 >= len(segments) {
+			break
+		}
+		cmd = strings.TrimSpace(cmd)
+
+		segment := segments[i+1]
+		if trimmed := strings.TrimLeft(segment, " "); strings.HasPrefix(trimmed, cmd) {
+			segment = trimmed[len(cmd):]
+		}
+
+		buf.WriteString(prompt + " " + cmd)
+		if !strings.HasPrefix(segment, "\n") {
+			buf.WriteString("\n")
+		}
+		buf.WriteString(segment)
+	}
+	return buf.String()
+}
+
+// splitPrompts splits stdout like strings.Split, but only at prompts which start a line
+// as output such as message text may mention the prompt.
+func splitPrompts(stdout string) []string {
+	var segments []string
+	start := 0
+	for i := 0; i < len(stdout); {
+		n := strings.Index(stdout[i:], prompt)
+		if n < 0 {
+			break
+		}
+		n += i
+		if n == 0 || stdout[n-1] == '\n' {
+			segments = append(segments, stdout[start:n])
+			start = n + len(prompt)
+		}
+		i = n + len(prompt)
+	}
+	return append(segments, stdout[start:])
+}
+
+// SelectStatistics runs a single "select statistics" command and returns its parsed output.
+// A CommandError is returned when the command does not complete successfully.
+func (c *Client) SelectStatistics(ctx context.Context, sel *command.SelectStatistics) (parser.Command, error) {
+	if err := sel.Validate(); err != nil {
+		return parser.Command{}, err
+	}
+
+	transcript, err := c.Run(ctx, sel.String())
+	if err != nil {
+		return parser.Command{}, err
+	}
+
+	result := transcript.Commands[0]
+	if !result.Succeeded() {
+		return result, &CommandError{
+			Command: result.Text,
+			Status:  result.Status,
+		}
+	}
+	return result, nil
+}
+
+// Summary runs a "select statistics" command and returns the summary records.
+func (c *Client) Summary(ctx context.Context, sel *command.SelectStatistics) (parser.SummaryStats, error) {
+	if sel != nil && sel.Detail {
+		return parser.SummaryStats{}, errors.New("summary requested with detail parameter")
+	}
+
+	result, err := c.SelectStatistics(ctx, sel)
+	if err != nil {
+		return parser.SummaryStats{}, err
+	}
+	if result.Summary == nil {
+		return parser.SummaryStats{}, nil
+	}
+	return *result.Summary, nil
+}
+
+// Detail runs a "select statistics ... detail" command and returns the detail records.
+func (c *Client) Detail(ctx context.Context, sel *command.SelectStatistics) (parser.DetailStats, error) {
+	if sel == nil {
+		return parser.DetailStats{}, errors.New("nil SelectStatistics")
+	}
+	withDetail := *sel
+	withDetail.Detail = true
+
+	result, err := c.SelectStatistics(ctx, &withDetail)
+	if err != nil {
+		return parser.DetailStats{}, err
+	}
+	if result.Detail == nil {
+		return parser.DetailStats{}, nil
+	}
+	return *result.Detail, nil
+}
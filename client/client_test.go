@@ -0,0 +1,175 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/moov-io/go-connect-direct/client"
+	"github.com/moov-io/go-connect-direct/command"
+	"github.com/moov-io/go-connect-direct/parser"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newClientPath(t *testing.T) string {
+	t.Helper()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("fake direct CLI is a shell script")
+	}
+
+	path, err := filepath.Abs(filepath.Join("testdata", "direct"))
+	require.NoError(t, err)
+	return path
+}
+
+func newClient(t *testing.T) *client.Client {
+	t.Helper()
+
+	cc, err := client.New(client.Config{
+		BinaryPath: newClientPath(t),
+		APIConfig:  "/opt/cdunix/ndm/cfg/cliapi/ndmapi.cfg",
+		Timeout:    10 * time.Second,
+	})
+	require.NoError(t, err)
+	return cc
+}
+
+func TestClient_Run(t *testing.T) {
+	cc := newClient(t)
+
+	transcript, err := cc.Run(context.Background(), "sel stat pnumber=14;", "sel stat pnumber=99;", "sel stat pnumber=13 detail;")
+	require.NoError(t, err)
+	require.Len(t, transcript.Commands, 3)
+
+	cmd := transcript.Commands[0]
+	require.Equal(t, "sel stat pnumber=14;", cmd.Text)
+	require.True(t, cmd.Succeeded())
+	require.Len(t, cmd.Summary.Stats, 8)
+
+	cmd = transcript.Commands[1]
+	require.Equal(t, "sel stat pnumber=99;", cmd.Text)
+	require.False(t, cmd.Succeeded())
+	require.Equal(t, "Select Statistics Failed.", cmd.Status)
+
+	cmd = transcript.Commands[2]
+	require.True(t, cmd.Succeeded())
+	require.Len(t, cmd.Detail.Stats, 15)
+
+	require.Len(t, transcript.Failed(), 1)
+}
+
+func TestClient_RunPromptInOutput(t *testing.T) {
+	cc := newClient(t)
+
+	transcript, err := cc.Run(context.Background(), "sel stat pnumber=22;", "sel stat pnumber=14;")
+	require.NoError(t, err)
+	require.Len(t, transcript.Commands, 2)
+
+	cmd := transcript.Commands[0]
+	require.Equal(t, "sel stat pnumber=22;", cmd.Text)
+	require.True(t, cmd.Succeeded())
+	require.Len(t, cmd.Summary.Stats, 1)
+	require.Equal(t, "Direct> prompt closed by the operator while Direct> was waiting for input.", cmd.Summary.Stats[0].Description)
+
+	cmd = transcript.Commands[1]
+	require.Equal(t, "sel stat pnumber=14;", cmd.Text)
+	require.Len(t, cmd.Summary.Stats, 8)
+}
+
+func TestClient_Summary(t *testing.T) {
+	cc := newClient(t)
+	ctx := context.Background()
+
+	stats, err := cc.Summary(ctx, command.NewSelectStatistics().PNumber(14))
+	require.NoError(t, err)
+	require.Len(t, stats.Stats, 8)
+	require.Equal(t, parser.SubmitProcess, stats.Stats[0].ID)
+
+	_, err = cc.Summary(ctx, command.NewSelectStatistics().PNumber(99))
+	var cmdErr *client.CommandError
+	require.ErrorAs(t, err, &cmdErr)
+	require.Equal(t, "sel stat pnumber=99;", cmdErr.Command)
+	require.Equal(t, "Select Statistics Failed.", cmdErr.Status)
+
+	_, err = cc.Summary(ctx, command.NewSelectStatistics().PNumber(0))
+	require.ErrorContains(t, err, "invalid pnumber")
+
+	_, err = cc.Summary(ctx, command.NewSelectStatistics().PNumber(14).WithDetail())
+	require.ErrorContains(t, err, "detail")
+}
+
+func TestClient_Detail(t *testing.T) {
+	cc := newClient(t)
+
+	sel := command.NewSelectStatistics().PNumber(13)
+	stats, err := cc.Detail(context.Background(), sel)
+	require.NoError(t, err)
+	require.Len(t, stats.Stats, 15)
+	require.False(t, sel.Detail)
+}
+
+func TestClient_Errors(t *testing.T) {
+	path := newClientPath(t)
+
+	t.Run("timeout", func(t *testing.T) {
+		cc, err := client.New(client.Config{
+			BinaryPath: path,
+			APIConfig:  "ndmapi.cfg",
+			Timeout:    100 * time.Millisecond,
+		})
+		require.NoError(t, err)
+
+		_, err = cc.Run(context.Background(), "sel stat pnumber=98;")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		cc, err := client.New(client.Config{
+			BinaryPath: path,
+			APIConfig:  "ndmapi.cfg",
+		})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err = cc.Run(ctx, "sel stat pnumber=14;")
+		require.True(t, errors.Is(err, context.Canceled))
+	})
+
+	t.Run("missing config", func(t *testing.T) {
+		cc, err := client.New(client.Config{
+			BinaryPath: path,
+		})
+		require.NoError(t, err)
+
+		_, err = cc.Run(context.Background(), "sel stat pnumber=14;")
+		require.ErrorContains(t, err, "NDMAPICFG is not set")
+	})
+
+	t.Run("missing binary", func(t *testing.T) {
+		cc, err := client.New(client.Config{
+			BinaryPath: filepath.Join("testdata", "missing"),
+		})
+		require.NoError(t, err)
+
+		_, err = cc.Run(context.Background(), "sel stat pnumber=14;")
+		require.Error(t, err)
+	})
+
+	t.Run("invalid command", func(t *testing.T) {
+		cc, err := client.New(client.Config{})
+		require.NoError(t, err)
+
+		_, err = cc.Run(context.Background(), "sel stat pnumber=14")
+		require.ErrorContains(t, err, "semicolon")
+
+		_, err = cc.Run(context.Background())
+		require.ErrorContains(t, err, "no commands")
+	})
+}
@@ -0,0 +1,222 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/moov-io/go-connect-direct/command"
+	"github.com/moov-io/go-connect-direct/parser"
+)
+
+type WaitOptions struct {
+	// PollInterval is how often statistics are selected while waiting. Defaults to five seconds.
+	PollInterval time.Duration
+
+	// Deadline limits how long to wait for the process to end. Zero means no limit beyond the context.
+	Deadline time.Duration
+
+	// Since is when the process was submitted. Process numbers are reused, so statistics logged
+	// before Since belong to an earlier process and are not selected. SubmitAndWait sets it.
+	Since time.Time
+
+	// ClockSkew is how far the server's clock may be behind this one. Statistics are selected from
+	// Since minus ClockSkew, so a server clock that is behind doesn't hide the new process. Defaults
+	// to five minutes. Servers in another timezone need the offset between the two added to it.
+	ClockSkew time.Duration
+}
+
+const (
+	defaultPollInterval = 5 * time.Second
+	defaultClockSkew    = 5 * time.Minute
+)
+
+var (
+	// ErrProcessWarning is returned when a process ends with CompletionCodeWarning
+	ErrProcessWarning = errors.New("process completed with warnings")
+
+	// ErrProcessError is returned when a process ends with CompletionCodeError
+	ErrProcessError = errors.New("process failed")
+
+	// ErrProcessCatastrophic is returned when a process ends with CompletionCodeCatastrophicError
+	ErrProcessCatastrophic = errors.New("process failed catastrophically")
+)
+
+// ProcessError is returned when a process ends with a non-zero completion code.
+// It wraps one of ErrProcessWarning, ErrProcessError or ErrProcessCatastrophic.
+type ProcessError struct {
+	Process parser.Process
+}
+
+func (e *ProcessError) Error() string {
+	return fmt.Sprintf("process %s (%s) ended with completion code %d (%s): %v",
+		e.Process.Number, e.Process.Name, e.Process.Code, e.Process.MessageID, e.Unwrap())
+}
+
+func (e *ProcessError) Unwrap() error {
+	switch {
+	case e.Process.Code >= parser.CompletionCodeCatastrophicError:
+		return ErrProcessCatastrophic
+	case e.Process.Code >= parser.CompletionCodeError:
+		return ErrProcessError
+	default:
+		return ErrProcessWarning
+	}
+}
+
+// Submit runs a "submit" command and returns the process number which Connect:Direct assigned.
+func (c *Client) Submit(ctx context.Context, sub *command.Submit) (int, error) {
+	if err := sub.Validate(); err != nil {
+		return 0, err
+	}
+
+	transcript, err := c.Run(ctx, sub.String())
+	if err != nil {
+		return 0, err
+	}
+
+	result := transcript.Commands[0]
+	pnumber, found := parseProcessNumber(result.Output)
+	if !found {
+		return 0, &CommandError{
+			Command: result.Text,
+			Status:  result.Status,
+		}
+	}
+	return pnumber, nil
+}
+
+var processNumberRegex = regexp.MustCompile(`(?i)Process\s+Number\s*=\s*(\d+)`)
+
+// parseProcessNumber finds the process number in the response to a submit command, such as
+//
+//	Process Submitted, Process Number = 14
+func parseProcessNumber(output string) (int, bool) {
+	match := processNumberRegex.FindStringSubmatch(output)
+	if len(match) < 2 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// Wait selects statistics for a process until its process ended (PRED) record is found.
+// Records from before the process's last process started (PSTR) record are ignored, as they
+// belong to an earlier process which had the same number. An earlier process which ended within
+// ClockSkew of Since can't be told apart until the new process starts.
+//
+// A ProcessError is returned along with the process when it ends with a non-zero completion code.
+func (c *Client) Wait(ctx context.Context, pnumber int, opts WaitOptions) (parser.Process, error) {
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	if opts.Deadline > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Deadline)
+		defer cancel()
+	}
+
+	since := opts.Since
+	if !since.IsZero() {
+		skew := opts.ClockSkew
+		if skew <= 0 {
+			skew = defaultClockSkew
+		}
+		since = since.Add(-skew)
+	}
+
+	sel := command.NewSelectStatistics().PNumber(pnumber)
+	if !since.IsZero() {
+		sel.StartT(since.Truncate(time.Second))
+	}
+	number := strconv.Itoa(pnumber)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		stats, err := c.Summary(ctx, sel)
+		if err != nil {
+			return parser.Process{}, fmt.Errorf("waiting for process %d: %w", pnumber, err)
+		}
+
+		stats.Stats = currentRun(stats.Stats, number, since)
+
+		for _, proc := range stats.Processes() {
+			if proc.Number != number || !proc.HasEnded() {
+				continue
+			}
+			if proc.Code != parser.CompletionCodeSuccess {
+				return proc, &ProcessError{Process: proc}
+			}
+			return proc, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return parser.Process{}, fmt.Errorf("waiting for process %d: %w", pnumber, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// currentRun drops the records for a process number which were logged before since, or before
+// the number's last process started (PSTR) record, along with the submit (SUBP) records ahead
+// of them. Statistics dates are the server's wall clock, so since is compared using its local
+// date and time.
+func currentRun(stats []parser.SummaryStat, number string, since time.Time) []parser.SummaryStat {
+	var start time.Time
+	if !since.IsZero() {
+		since = since.Truncate(time.Second)
+		start = time.Date(since.Year(), since.Month(), since.Day(), since.Hour(), since.Minute(), since.Second(), 0, time.UTC)
+	}
+	for _, stat := range stats {
+		if stat.ProcessNumber == number && stat.ID.ID == parser.ProcessStarted.ID && stat.Date.After(start) {
+			start = stat.Date
+		}
+	}
+
+	stale := -1
+	for i, stat := range stats {
+		if stat.ProcessNumber == number && stat.Date.Before(start) {
+			stale = i
+		}
+	}
+
+	var out []parser.SummaryStat
+	for i, stat := range stats {
+		if stat.ProcessNumber == number && stat.Date.Before(start) {
+			continue
+		}
+		if stat.ProcessNumber == "" && i < stale {
+			continue
+		}
+		out = append(out, stat)
+	}
+	return out
+}
+
+// SubmitAndWait submits a process and waits for it to end, returning its final outcome.
+func (c *Client) SubmitAndWait(ctx context.Context, sub *command.Submit, opts WaitOptions) (parser.Process, error) {
+	if opts.Deadline > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Deadline)
+		defer cancel()
+	}
+	if opts.Since.IsZero() {
+		opts.Since = time.Now()
+	}
+
+	pnumber, err := c.Submit(ctx, sub)
+	if err != nil {
+		return parser.Process{}, err
+	}
+	return c.Wait(ctx, pnumber, opts)
+}
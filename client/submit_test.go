@@ -0,0 +1,130 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/moov-io/go-connect-direct/client"
+	"github.com/moov-io/go-connect-direct/command"
+	"github.com/moov-io/go-connect-direct/parser"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Submit(t *testing.T) {
+	cc := newClient(t)
+	ctx := context.Background()
+
+	pnumber, err := cc.Submit(ctx, command.NewSubmit("sample.cdp").Symbolic("&FILE", "/data/outbound/ach.txt"))
+	require.NoError(t, err)
+	require.Equal(t, 14, pnumber)
+
+	_, err = cc.Submit(ctx, command.NewSubmit("missing.cdp"))
+	var cmdErr *client.CommandError
+	require.ErrorAs(t, err, &cmdErr)
+	require.Equal(t, "Submit Process Failed.", cmdErr.Status)
+
+	_, err = cc.Submit(ctx, command.NewSubmit(""))
+	require.ErrorContains(t, err, "missing process file")
+}
+
+func TestClient_SubmitAndWait(t *testing.T) {
+	cc := newClient(t)
+	ctx := context.Background()
+	opts := client.WaitOptions{
+		PollInterval: 10 * time.Millisecond,
+		Deadline:     10 * time.Second,
+	}
+
+	proc, err := cc.SubmitAndWait(ctx, command.NewSubmit("sample.cdp"), opts)
+	require.NoError(t, err)
+	require.Equal(t, "14", proc.Number)
+	require.True(t, proc.Succeeded())
+	require.Equal(t, "XSMG252I", proc.MessageID)
+	require.Len(t, proc.Copies, 2)
+
+	cases := []struct {
+		file     string
+		code     int
+		expected error
+	}{
+		{"warning.cdp", parser.CompletionCodeWarning, client.ErrProcessWarning},
+		{"error.cdp", parser.CompletionCodeError, client.ErrProcessError},
+		{"catastrophic.cdp", parser.CompletionCodeCatastrophicError, client.ErrProcessCatastrophic},
+	}
+	for _, tc := range cases {
+		t.Run(tc.file, func(t *testing.T) {
+			proc, err := cc.SubmitAndWait(ctx, command.NewSubmit(tc.file), opts)
+			require.ErrorIs(t, err, tc.expected)
+
+			var procErr *client.ProcessError
+			require.ErrorAs(t, err, &procErr)
+			require.Equal(t, tc.code, procErr.Process.Code)
+			require.Equal(t, tc.code, proc.Code)
+			require.Len(t, proc.Copies, 1)
+		})
+	}
+}
+
+func TestClient_Wait(t *testing.T) {
+	cc, err := client.New(client.Config{
+		BinaryPath: newClientPath(t),
+		APIConfig:  "ndmapi.cfg",
+		Env:        []string{"DIRECT_STATE_DIR=" + t.TempDir()},
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("polls", func(t *testing.T) {
+		proc, err := cc.Wait(ctx, 19, client.WaitOptions{PollInterval: 10 * time.Millisecond})
+		require.NoError(t, err)
+		require.True(t, proc.HasEnded())
+		require.True(t, proc.Succeeded())
+	})
+
+	t.Run("reused number", func(t *testing.T) {
+		// The failed records of an earlier process 20 are ignored
+		proc, err := cc.SubmitAndWait(ctx, command.NewSubmit("reused.cdp"), client.WaitOptions{
+			PollInterval: 10 * time.Millisecond,
+			Deadline:     10 * time.Second,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "20", proc.Number)
+		require.True(t, proc.Succeeded())
+		require.Len(t, proc.Stats, 3)
+		require.False(t, proc.Submitted.IsZero())
+		require.Equal(t, proc.Submitted, proc.Started)
+	})
+
+	t.Run("server clock behind", func(t *testing.T) {
+		// Process 21 started at 23:28:45 on the server, two minutes before this clock's submit time
+		since := time.Date(2026, time.February, 3, 23, 30, 45, 0, time.UTC)
+
+		proc, err := cc.Wait(ctx, 21, client.WaitOptions{
+			PollInterval: 10 * time.Millisecond,
+			Deadline:     time.Second,
+			Since:        since,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "21", proc.Number)
+		require.True(t, proc.Succeeded())
+
+		_, err = cc.Wait(ctx, 21, client.WaitOptions{
+			PollInterval: 10 * time.Millisecond,
+			Deadline:     200 * time.Millisecond,
+			Since:        since,
+			ClockSkew:    time.Minute,
+		})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		_, err := cc.SubmitAndWait(ctx, command.NewSubmit("running.cdp"), client.WaitOptions{
+			PollInterval: 10 * time.Millisecond,
+			Deadline:     200 * time.Millisecond,
+		})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -0,0 +1,517 @@
+// Package command builds Connect:Direct CLI commands.
+package command
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SelectStatistics builds a "select statistics" command with typed filters.
+//
+//	cmd := command.NewSelectStatistics().CCode(command.GreaterOrEqual, 0).PNumber(21)
+//	cmd.String() // sel stat ccode(ge,0) pnumber=21;
+type SelectStatistics struct {
+	CompletionCode *CompletionCodeFilter
+
+	ProcessNames   []string
+	ProcessNumbers []int
+	RecordIDs      []string
+	SNodes         []string
+	Submitters     []Submitter
+
+	SourceFile      string
+	DestinationFile string
+
+	StartTime *DateTime
+	StopTime  *DateTime
+
+	Detail bool
+}
+
+// CompletionCodeFilter selects records by comparing their completion code, such as ccode(ge,4)
+type CompletionCodeFilter struct {
+	Operator Operator
+	Code     int
+}
+
+type Operator string
+
+var (
+	Equal          Operator = "eq"
+	NotEqual       Operator = "ne"
+	GreaterThan    Operator = "gt"
+	GreaterOrEqual Operator = "ge"
+	LessThan       Operator = "lt"
+	LessOrEqual    Operator = "le"
+)
+
+// Submitter is a node and user id pair which submitted processes
+type Submitter struct {
+	Node   string
+	UserID string
+}
+
+// DateTime is a startt or stopt value. The date is written from Day when set (e.g. "today" or "monday"),
+// otherwise from Date. The time of day from Date is only written when WithTime is true.
+type DateTime struct {
+	Day      string
+	Date     time.Time
+	WithTime bool
+}
+
+const (
+	dateLayout = "01/02/2006"
+	timeLayout = "15:04:05"
+)
+
+var days = []string{"today", "yesterday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+func NewSelectStatistics() *SelectStatistics {
+	return &SelectStatistics{}
+}
+
+func (s *SelectStatistics) CCode(op Operator, code int) *SelectStatistics {
+	s.CompletionCode = &CompletionCodeFilter{Operator: op, Code: code}
+	return s
+}
+
+func (s *SelectStatistics) PName(names ...string) *SelectStatistics {
+	s.ProcessNames = append(s.ProcessNames, names...)
+	return s
+}
+
+func (s *SelectStatistics) PNumber(numbers ...int) *SelectStatistics {
+	s.ProcessNumbers = append(s.ProcessNumbers, numbers...)
+	return s
+}
+
+func (s *SelectStatistics) RecIDs(ids ...string) *SelectStatistics {
+	s.RecordIDs = append(s.RecordIDs, ids...)
+	return s
+}
+
+func (s *SelectStatistics) SNode(nodes ...string) *SelectStatistics {
+	s.SNodes = append(s.SNodes, nodes...)
+	return s
+}
+
+func (s *SelectStatistics) Submitter(node, userID string) *SelectStatistics {
+	s.Submitters = append(s.Submitters, Submitter{Node: node, UserID: userID})
+	return s
+}
+
+func (s *SelectStatistics) SrcFile(path string) *SelectStatistics {
+	s.SourceFile = path
+	return s
+}
+
+func (s *SelectStatistics) DestFile(path string) *SelectStatistics {
+	s.DestinationFile = path
+	return s
+}
+
+// StartT selects records logged at or after when
+func (s *SelectStatistics) StartT(when time.Time) *SelectStatistics {
+	s.StartTime = &DateTime{Date: when, WithTime: true}
+	return s
+}
+
+// StopT selects records logged at or before when
+func (s *SelectStatistics) StopT(when time.Time) *SelectStatistics {
+	s.StopTime = &DateTime{Date: when, WithTime: true}
+	return s
+}
+
+func (s *SelectStatistics) WithDetail() *SelectStatistics {
+	s.Detail = true
+	return s
+}
+
+// Validate checks each filter against the values Connect:Direct accepts.
+func (s *SelectStatistics) Validate() error {
+	if s == nil {
+		return errors.New("nil SelectStatistics")
+	}
+
+	if cc := s.CompletionCode; cc != nil {
+		if !validOperator(cc.Operator) {
+			return fmt.Errorf("invalid ccode operator %q", cc.Operator)
+		}
+		if cc.Code < 0 {
+			return fmt.Errorf("invalid ccode %d", cc.Code)
+		}
+	}
+	for _, name := range s.ProcessNames {
+		if err := validateName(name, 8); err != nil {
+			return fmt.Errorf("invalid pname: %w", err)
+		}
+	}
+	for _, num := range s.ProcessNumbers {
+		if num <= 0 {
+			return fmt.Errorf("invalid pnumber %d", num)
+		}
+	}
+	for _, id := range s.RecordIDs {
+		// Most record IDs are four characters, but some like TROFF are five
+		if err := validateName(id, 5); err != nil {
+			return fmt.Errorf("invalid recid: %w", err)
+		}
+	}
+	for _, node := range s.SNodes {
+		if err := validateName(node, 16); err != nil {
+			return fmt.Errorf("invalid snode: %w", err)
+		}
+	}
+	for _, sub := range s.Submitters {
+		if sub.Node == "" || sub.UserID == "" {
+			return errors.New("invalid submitter: node and userid are required")
+		}
+		if strings.ContainsAny(sub.Node+sub.UserID, ` ,;()"`) {
+			return fmt.Errorf("invalid submitter %s@%s", sub.UserID, sub.Node)
+		}
+	}
+	if strings.Contains(s.SourceFile, `"`) {
+		return fmt.Errorf("invalid srcfile %s", s.SourceFile)
+	}
+	if strings.Contains(s.DestinationFile, `"`) {
+		return fmt.Errorf("invalid destfile %s", s.DestinationFile)
+	}
+	for _, dt := range []*DateTime{s.StartTime, s.StopTime} {
+		if dt == nil {
+			continue
+		}
+		if dt.Day != "" && !validDay(dt.Day) {
+			return fmt.Errorf("invalid day %q", dt.Day)
+		}
+		if dt.Day == "" && dt.Date.IsZero() {
+			return errors.New("missing date")
+		}
+	}
+	if s.StartTime != nil && s.StopTime != nil && s.StartTime.Day == "" && s.StopTime.Day == "" {
+		if s.StopTime.Date.Before(s.StartTime.Date) {
+			return errors.New("stopt is before startt")
+		}
+	}
+
+	return nil
+}
+
+// String returns the command as it would be typed at the "Direct>" prompt.
+func (s *SelectStatistics) String() string {
+	params := []string{"sel", "stat"}
+
+	if cc := s.CompletionCode; cc != nil {
+		params = append(params, fmt.Sprintf("ccode(%s,%d)", cc.Operator, cc.Code))
+	}
+	if len(s.ProcessNames) > 0 {
+		params = append(params, "pname="+list(s.ProcessNames))
+	}
+	if len(s.ProcessNumbers) > 0 {
+		var nums []string
+		for _, n := range s.ProcessNumbers {
+			nums = append(nums, strconv.Itoa(n))
+		}
+		params = append(params, "pnumber="+list(nums))
+	}
+	if len(s.RecordIDs) > 0 {
+		params = append(params, "recids=("+strings.Join(s.RecordIDs, ",")+")")
+	}
+	if len(s.SNodes) > 0 {
+		params = append(params, "snode="+list(s.SNodes))
+	}
+	if s.SourceFile != "" {
+		params = append(params, "srcfile="+quote(s.SourceFile))
+	}
+	if s.DestinationFile != "" {
+		params = append(params, "destfile="+quote(s.DestinationFile))
+	}
+	if s.StartTime != nil {
+		params = append(params, "startt="+s.StartTime.String())
+	}
+	if s.StopTime != nil {
+		params = append(params, "stopt="+s.StopTime.String())
+	}
+	if len(s.Submitters) > 0 {
+		var subs []string
+		for _, sub := range s.Submitters {
+			subs = append(subs, fmt.Sprintf("(%s,%s)", sub.Node, sub.UserID))
+		}
+		if len(subs) == 1 {
+			params = append(params, "submitter="+subs[0])
+		} else {
+			params = append(params, "submitter=("+strings.Join(subs, ",")+")")
+		}
+	}
+	if s.Detail {
+		params = append(params, "detail")
+	}
+
+	return strings.Join(params, " ") + ";"
+}
+
+// String formats the value as "(02/03/2026,23:28:45)"
+func (dt DateTime) String() string {
+	date := dt.Day
+	if date == "" {
+		date = dt.Date.Format(dateLayout)
+	}
+	if dt.WithTime {
+		return fmt.Sprintf("(%s,%s)", date, dt.Date.Format(timeLayout))
+	}
+	return "(" + date + ")"
+}
+
+func list(values []string) string {
+	if len(values) == 1 {
+		return values[0]
+	}
+	return "(" + strings.Join(values, ",") + ")"
+}
+
+// quote wraps file names which contain characters that would otherwise end the parameter
+func quote(path string) string {
+	if strings.ContainsAny(path, " \t,;()=") {
+		return `"` + path + `"`
+	}
+	return path
+}
+
+func validOperator(op Operator) bool {
+	switch op {
+	case Equal, NotEqual, GreaterThan, GreaterOrEqual, LessThan, LessOrEqual:
+		return true
+	}
+	return false
+}
+
+func validDay(day string) bool {
+	for _, d := range days {
+		if strings.EqualFold(d, day) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateName(name string, maxLength int) error {
+	if name == "" {
+		return errors.New("empty value")
+	}
+	if len(name) > maxLength {
+		return fmt.Errorf("%s is longer than %d characters", name, maxLength)
+	}
+	for _, r := range name {
+		isLetter := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		isDigit := r >= '0' && r <= '9'
+		if !isLetter && !isDigit && r != '.' && r != '_' && r != '-' && r != '@' && r != '#' && r != '$' {
+			return fmt.Errorf("%s contains invalid character %q", name, r)
+		}
+	}
+	return nil
+}
+
+// ParseSelectStatistics reads a "select statistics" command, such as one echoed after a "Direct>" prompt.
+//
+//	sel stat ccode(ge,0) pnumber=21;
+//	select statistics pnumber=13 detail=yes;
+func ParseSelectStatistics(text string) (*SelectStatistics, error) {
+	tokens, err := tokenize(strings.TrimSuffix(strings.TrimSpace(text), ";"))
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) < 2 {
+		return nil, fmt.Errorf("not a select statistics command: %q", text)
+	}
+
+	verb, noun := strings.ToLower(tokens[0]), strings.ToLower(tokens[1])
+	if (verb != "sel" && verb != "select") || !strings.HasPrefix("statistics", noun) || len(noun) < 4 {
+		return nil, fmt.Errorf("not a select statistics command: %q", text)
+	}
+
+	out := NewSelectStatistics()
+	for _, token := range tokens[2:] {
+		name, value := splitParam(token)
+
+		switch strings.ToLower(name) {
+		case "ccode":
+			parts := splitList(value)
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("invalid ccode %q", value)
+			}
+			code, err := strconv.Atoi(parts[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid ccode %q: %w", value, err)
+			}
+			out.CCode(Operator(strings.ToLower(parts[0])), code)
+
+		case "pname":
+			out.PName(splitList(value)...)
+
+		case "pnumber":
+			for _, p := range splitList(value) {
+				n, err := strconv.Atoi(p)
+				if err != nil {
+					return nil, fmt.Errorf("invalid pnumber %q: %w", p, err)
+				}
+				out.PNumber(n)
+			}
+
+		case "recids":
+			out.RecIDs(splitList(value)...)
+
+		case "snode":
+			out.SNode(splitList(value)...)
+
+		case "submitter":
+			// Either (node,userid) or ((node,userid),(node,userid))
+			subs := []string{value}
+			if strings.HasPrefix(trimParens(value), "(") {
+				subs = splitList(value)
+			}
+			for _, sub := range subs {
+				parts := splitList(sub)
+				if len(parts) != 2 {
+					return nil, fmt.Errorf("invalid submitter %q", sub)
+				}
+				out.Submitter(parts[0], parts[1])
+			}
+
+		case "srcfile":
+			out.SrcFile(strings.Trim(value, `"`))
+
+		case "destfile":
+			out.DestFile(strings.Trim(value, `"`))
+
+		case "startt", "stopt":
+			dt, err := parseDateTime(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", name, err)
+			}
+			if strings.EqualFold(name, "startt") {
+				out.StartTime = dt
+			} else {
+				out.StopTime = dt
+			}
+
+		case "detail":
+			out.Detail = value == "" || strings.EqualFold(value, "yes")
+
+		default:
+			return nil, fmt.Errorf("unknown parameter %q", name)
+		}
+	}
+
+	return out, out.Validate()
+}
+
+// tokenize splits parameters on whitespace outside of parentheses and quotes
+func tokenize(text string) ([]string, error) {
+	var out []string
+	var current strings.Builder
+	var depth int
+	var quoted bool
+
+	for _, r := range text {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case quoted:
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+			if depth < 0 {
+				return nil, errors.New("unbalanced parentheses")
+			}
+		case (r == ' ' || r == '\t' || r == '\n') && depth == 0:
+			if current.Len() > 0 {
+				out = append(out, current.String())
+				current.Reset()
+			}
+			continue
+		}
+		current.WriteRune(r)
+	}
+	if quoted {
+		return nil, errors.New("unterminated quote")
+	}
+	if depth != 0 {
+		return nil, errors.New("unbalanced parentheses")
+	}
+	if current.Len() > 0 {
+		out = append(out, current.String())
+	}
+	return out, nil
+}
+
+// splitParam reads "name=value" and "name(value)" parameters
+func splitParam(token string) (string, string) {
+	idx := strings.IndexAny(token, "=(")
+	if idx < 0 {
+		return token, ""
+	}
+	if token[idx] == '=' {
+		return token[:idx], token[idx+1:]
+	}
+	return token[:idx], token[idx:]
+}
+
+func trimParens(value string) string {
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+// splitList reads "(a,b,c)" or "a" into its values, keeping nested parentheses together
+func splitList(value string) []string {
+	value = trimParens(value)
+
+	var out []string
+	var depth, start int
+	for i, r := range value {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				out = append(out, strings.TrimSpace(value[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(out, strings.TrimSpace(value[start:]))
+}
+
+func parseDateTime(value string) (*DateTime, error) {
+	parts := splitList(value)
+	if len(parts) > 2 {
+		return nil, fmt.Errorf("unexpected value %q", value)
+	}
+
+	out := &DateTime{}
+	if validDay(parts[0]) {
+		out.Day = strings.ToLower(parts[0])
+	} else {
+		date, err := time.Parse(dateLayout, parts[0])
+		if err != nil {
+			return nil, err
+		}
+		out.Date = date
+	}
+
+	if len(parts) == 2 {
+		clock, err := time.Parse(timeLayout, parts[1])
+		if err != nil {
+			return nil, err
+		}
+		out.Date = time.Date(out.Date.Year(), out.Date.Month(), out.Date.Day(), clock.Hour(), clock.Minute(), clock.Second(), 0, time.UTC)
+		out.WithTime = true
+	}
+	return out, nil
+}
@@ -0,0 +1,129 @@
+package command_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/moov-io/go-connect-direct/command"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectStatistics_String(t *testing.T) {
+	cases := []struct {
+		cmd      *command.SelectStatistics
+		expected string
+	}{
+		{
+			cmd:      command.NewSelectStatistics().CCode(command.GreaterOrEqual, 0).PNumber(21),
+			expected: "sel stat ccode(ge,0) pnumber=21;",
+		},
+		{
+			cmd:      command.NewSelectStatistics().CCode(command.LessOrEqual, 4).PNumber(14),
+			expected: "sel stat ccode(le,4) pnumber=14;",
+		},
+		{
+			cmd:      command.NewSelectStatistics().PNumber(13).WithDetail(),
+			expected: "sel stat pnumber=13 detail;",
+		},
+		{
+			cmd:      command.NewSelectStatistics().PName("sample", "SENDFILE").RecIDs("CTRC", "PRED").SNode("cdnode"),
+			expected: "sel stat pname=(sample,SENDFILE) recids=(CTRC,PRED) snode=cdnode;",
+		},
+		{
+			cmd:      command.NewSelectStatistics().RecIDs("TRON", "TROFF"),
+			expected: "sel stat recids=(TRON,TROFF);",
+		},
+		{
+			cmd: command.NewSelectStatistics().
+				SrcFile("/data/outbound/ach file.txt").
+				DestFile("gs://moov-ibmcd-fedach-7b2egd/outbound/1770161197.txt").
+				StartT(time.Date(2026, time.February, 3, 23, 0, 0, 0, time.UTC)).
+				StopT(time.Date(2026, time.February, 4, 1, 30, 0, 0, time.UTC)),
+			expected: `sel stat srcfile="/data/outbound/ach file.txt" destfile=gs://moov-ibmcd-fedach-7b2egd/outbound/1770161197.txt startt=(02/03/2026,23:00:00) stopt=(02/04/2026,01:30:00);`,
+		},
+		{
+			cmd:      command.NewSelectStatistics().Submitter("cdnode", "cdadmin"),
+			expected: "sel stat submitter=(cdnode,cdadmin);",
+		},
+		{
+			cmd:      command.NewSelectStatistics().Submitter("cdnode", "cdadmin").Submitter("fedach", "moov"),
+			expected: "sel stat submitter=((cdnode,cdadmin),(fedach,moov));",
+		},
+		{
+			cmd: &command.SelectStatistics{
+				StartTime: &command.DateTime{Day: "yesterday"},
+				StopTime:  &command.DateTime{Day: "today", Date: time.Date(0, time.January, 1, 8, 0, 0, 0, time.UTC), WithTime: true},
+			},
+			expected: "sel stat startt=(yesterday) stopt=(today,08:00:00);",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.expected, func(t *testing.T) {
+			require.NoError(t, tc.cmd.Validate())
+			require.Equal(t, tc.expected, tc.cmd.String())
+
+			// Parse the command back and render it again
+			parsed, err := command.ParseSelectStatistics(tc.expected)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, parsed.String())
+		})
+	}
+}
+
+func TestSelectStatistics_Validate(t *testing.T) {
+	cases := []struct {
+		cmd      *command.SelectStatistics
+		expected string
+	}{
+		{command.NewSelectStatistics().CCode("gte", 0), `invalid ccode operator "gte"`},
+		{command.NewSelectStatistics().CCode(command.Equal, -1), "invalid ccode -1"},
+		{command.NewSelectStatistics().PName("longprocessname"), "invalid pname"},
+		{command.NewSelectStatistics().PName("bad name"), "invalid pname"},
+		{command.NewSelectStatistics().PNumber(0), "invalid pnumber 0"},
+		{command.NewSelectStatistics().RecIDs("CTRC;"), "invalid recid"},
+		{command.NewSelectStatistics().RecIDs("TRACEOFF"), "invalid recid: TRACEOFF is longer than 5 characters"},
+		{command.NewSelectStatistics().SNode(""), "invalid snode"},
+		{command.NewSelectStatistics().Submitter("cdnode", ""), "invalid submitter"},
+		{command.NewSelectStatistics().SrcFile(`a"b`), "invalid srcfile"},
+		{&command.SelectStatistics{StartTime: &command.DateTime{Day: "someday"}}, `invalid day "someday"`},
+		{&command.SelectStatistics{StopTime: &command.DateTime{}}, "missing date"},
+		{
+			command.NewSelectStatistics().
+				StartT(time.Date(2026, time.February, 4, 0, 0, 0, 0, time.UTC)).
+				StopT(time.Date(2026, time.February, 3, 0, 0, 0, 0, time.UTC)),
+			"stopt is before startt",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.expected, func(t *testing.T) {
+			require.ErrorContains(t, tc.cmd.Validate(), tc.expected)
+		})
+	}
+}
+
+func TestParseSelectStatistics(t *testing.T) {
+	cmd, err := command.ParseSelectStatistics("select statistics ccode=(GE,8) pnumber=(13,14) submitter=(cdnode,cdadmin) detail=yes;")
+	require.NoError(t, err)
+
+	expected := &command.SelectStatistics{
+		CompletionCode: &command.CompletionCodeFilter{Operator: command.GreaterOrEqual, Code: 8},
+		ProcessNumbers: []int{13, 14},
+		Submitters:     []command.Submitter{{Node: "cdnode", UserID: "cdadmin"}},
+		Detail:         true,
+	}
+	require.Equal(t, expected, cmd)
+	require.Equal(t, "sel stat ccode(ge,8) pnumber=(13,14) submitter=(cdnode,cdadmin) detail;", cmd.String())
+
+	_, err = command.ParseSelectStatistics("sel proc pnumber=13;")
+	require.ErrorContains(t, err, "not a select statistics command")
+
+	_, err = command.ParseSelectStatistics("sel stat pnumber=(13;")
+	require.ErrorContains(t, err, "unbalanced parentheses")
+
+	_, err = command.ParseSelectStatistics("sel stat pnumber=13 startt=(02/31/2026);")
+	require.ErrorContains(t, err, "invalid startt")
+
+	_, err = command.ParseSelectStatistics("sel stat color=red;")
+	require.ErrorContains(t, err, `unknown parameter "color"`)
+}
@@ -0,0 +1,77 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/moov-io/go-connect-direct/process"
+)
+
+// Submit builds a "submit" command for a process file with optional symbolic variables.
+//
+//	cmd := command.NewSubmit("/opt/cdunix/ndm/process/sample.cdp").Symbolic("&FILE", "/data/outbound/ach.txt")
+//	cmd.String() // submit file=/opt/cdunix/ndm/process/sample.cdp &FILE=/data/outbound/ach.txt;
+type Submit struct {
+	File string
+
+	// Symbolics are written in sorted order, keys include the leading ampersand
+	Symbolics map[string]string
+}
+
+func NewSubmit(file string) *Submit {
+	return &Submit{
+		File: file,
+	}
+}
+
+// Symbolic sets a symbolic variable, such as "&FILE", which is resolved when the process runs.
+func (s *Submit) Symbolic(name, value string) *Submit {
+	if s.Symbolics == nil {
+		s.Symbolics = make(map[string]string)
+	}
+	if !strings.HasPrefix(name, "&") {
+		name = "&" + name
+	}
+	s.Symbolics[name] = value
+	return s
+}
+
+func (s *Submit) Validate() error {
+	if s == nil {
+		return errors.New("nil Submit")
+	}
+	if s.File == "" {
+		return errors.New("missing process file")
+	}
+	if strings.Contains(s.File, `"`) {
+		return fmt.Errorf("invalid process file %s", s.File)
+	}
+	for name, value := range s.Symbolics {
+		if err := process.ValidateSymbolic(name); err != nil {
+			return fmt.Errorf("invalid symbolic name: %w", err)
+		}
+		if strings.Contains(value, `"`) {
+			return fmt.Errorf("invalid value for %s", name)
+		}
+	}
+	return nil
+}
+
+// String returns the command as it would be typed at the "Direct>" prompt.
+func (s *Submit) String() string {
+	params := []string{"submit", "file=" + quote(s.File)}
+
+	names := make([]string, 0, len(s.Symbolics))
+	for name := range s.Symbolics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		params = append(params, name+"="+quote(s.Symbolics[name]))
+	}
+
+	return strings.Join(params, " ") + ";"
+}
@@ -0,0 +1,32 @@
+package command_test
+
+import (
+	"testing"
+
+	"github.com/moov-io/go-connect-direct/command"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmit(t *testing.T) {
+	cmd := command.NewSubmit("/opt/cdunix/ndm/process/sample.cdp")
+	require.NoError(t, cmd.Validate())
+	require.Equal(t, "submit file=/opt/cdunix/ndm/process/sample.cdp;", cmd.String())
+
+	cmd.Symbolic("&FILE", "gs://moov-platform-staging-achgateway-fedach/outbound/1770161197.txt").Symbolic("DEST", "/data/inbound/ach file.txt")
+	require.NoError(t, cmd.Validate())
+	require.Equal(t, `submit file=/opt/cdunix/ndm/process/sample.cdp &DEST="/data/inbound/ach file.txt" &FILE=gs://moov-platform-staging-achgateway-fedach/outbound/1770161197.txt;`, cmd.String())
+}
+
+func TestSubmit_Validate(t *testing.T) {
+	require.ErrorContains(t, command.NewSubmit("").Validate(), "missing process file")
+	require.ErrorContains(t, command.NewSubmit(`a"b.cdp`).Validate(), "invalid process file")
+	require.ErrorContains(t, command.NewSubmit("sample.cdp").Symbolic("&BAD NAME", "x").Validate(), "invalid symbolic name")
+	require.NoError(t, command.NewSubmit("sample.cdp").Symbolic("&DESTINATION_FILE", "x").Validate())
+	require.EqualError(t, command.NewSubmit("sample.cdp").Symbolic("&ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456", "x").Validate(),
+		`invalid symbolic name: "&ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456" must be 1 to 32 characters after the &`)
+	require.ErrorContains(t, command.NewSubmit("sample.cdp").Symbolic("&FILE", `a"b`).Validate(), "invalid value for &FILE")
+
+	var cmd *command.Submit
+	require.Error(t, cmd.Validate())
+}
@@ -0,0 +1,167 @@
+// Package initparm reads and writes Connect:Direct for UNIX initparm.cfg files.
+//
+// initparm.cfg uses the same record format as netmap.cfg, so parsing and writing are shared with
+// the netmap package. Records such as "ndm.node" contain parameters such as "name":
+//
+//	ndm.node:name=cdnode:
+//
+//	tcq:\
+//	 :max.age=8:\
+//	 :ckpt.max.age=8:
+//
+// Comments and the layout of unchanged records are kept when a Config is written.
+package initparm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/go-connect-direct/netmap"
+)
+
+type (
+	Record = netmap.Record
+	Field  = netmap.Field
+)
+
+type Config struct {
+	file *netmap.Netmap
+}
+
+// Parse reads an initparm.cfg file.
+func Parse(input string) (*Config, error) {
+	file, err := netmap.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing initparm: %w", err)
+	}
+	return &Config{file: file}, nil
+}
+
+// Records returns every record in the order they were read.
+func (c *Config) Records() []*Record {
+	return c.file.Records
+}
+
+// Record returns the record with a matching name, such as "stats", or nil when not found.
+func (c *Config) Record(name string) *Record {
+	return c.file.Lookup(name)
+}
+
+// Get returns the value of a parameter within a record, such as Get("tcq", "max.age").
+func (c *Config) Get(record, key string) (string, bool) {
+	rec := c.Record(record)
+	if rec == nil {
+		return "", false
+	}
+	return rec.Get(key)
+}
+
+// Set changes a parameter, adding the record at the end of the file when it doesn't exist.
+func (c *Config) Set(record, key, value string) {
+	rec := c.Record(record)
+	if rec == nil {
+		rec = &Record{Name: record}
+		c.file.Add(rec)
+	}
+	rec.Set(key, value)
+}
+
+// String returns the config as it would be written to initparm.cfg.
+func (c *Config) String() string {
+	return c.file.String()
+}
+
+// Diff returns the parameters which differ between two configs, such as between the nodes of a cluster.
+func Diff(from, to *Config) []netmap.Change {
+	return netmap.Diff(from.file, to.file)
+}
+
+// Settings are the typed values of commonly used parameters. Parameters which are not set are left as zero values.
+type Settings struct {
+	NodeName string // ndm.node name
+	Path     string // ndm.path path
+
+	MaxCompletionCode int // ccode max
+	ProcessPriority   int // proc.prio default
+
+	TCQMaxAge           int // tcq max.age, in days
+	TCQCheckpointMaxAge int // tcq ckpt.max.age, in days
+
+	StatsFileSize    int    // stats file.size, in bytes
+	StatsMaxAge      int    // stats max.age, in days
+	StatsLogCommands bool   // stats log.commands
+	StatsLogSelect   bool   // stats log.select
+	StatsExitProgram string // stats stats.exit.program
+
+	RestrictCommands bool     // restrict cmd
+	TrustedAddresses []string // port.check trusted.addr
+
+	FileAgentEnabled bool // cdfa cdfa.enable
+}
+
+// Settings returns the typed values of known parameters. An error is returned when a value can't be read.
+func (c *Config) Settings() (Settings, error) {
+	var out Settings
+	var err error
+
+	str := func(record, key string, dest *string) {
+		*dest, _ = c.Get(record, key)
+	}
+	number := func(record, key string, dest *int) {
+		if value, _ := c.Get(record, key); value != "" && err == nil {
+			*dest, err = parseNumber(value)
+			if err != nil {
+				err = fmt.Errorf("%s %s: %v", record, key, err)
+			}
+		}
+	}
+	boolean := func(record, key string, dest *bool) {
+		if value, _ := c.Get(record, key); value != "" && err == nil {
+			*dest, err = parseBool(value)
+			if err != nil {
+				err = fmt.Errorf("%s %s: %v", record, key, err)
+			}
+		}
+	}
+
+	str("ndm.node", "name", &out.NodeName)
+	str("ndm.path", "path", &out.Path)
+	number("ccode", "max", &out.MaxCompletionCode)
+	number("proc.prio", "default", &out.ProcessPriority)
+	number("tcq", "max.age", &out.TCQMaxAge)
+	number("tcq", "ckpt.max.age", &out.TCQCheckpointMaxAge)
+	number("stats", "file.size", &out.StatsFileSize)
+	number("stats", "max.age", &out.StatsMaxAge)
+	boolean("stats", "log.commands", &out.StatsLogCommands)
+	boolean("stats", "log.select", &out.StatsLogSelect)
+	str("stats", "stats.exit.program", &out.StatsExitProgram)
+	boolean("restrict", "cmd", &out.RestrictCommands)
+	boolean("cdfa", "cdfa.enable", &out.FileAgentEnabled)
+
+	if value, _ := c.Get("port.check", "trusted.addr"); value != "" {
+		for _, addr := range strings.Split(value, ",") {
+			out.TrustedAddresses = append(out.TrustedAddresses, strings.TrimSpace(addr))
+		}
+	}
+
+	return out, err
+}
+
+func parseNumber(value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", value)
+	}
+	return n, nil
+}
+
+func parseBool(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "y", "yes":
+		return true, nil
+	case "n", "no":
+		return false, nil
+	}
+	return false, fmt.Errorf("expected y or n, found %q", value)
+}
@@ -0,0 +1,94 @@
+package initparm_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/go-connect-direct/initparm"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readConfig(t *testing.T) (string, *initparm.Config) {
+	t.Helper()
+
+	bs, err := os.ReadFile(filepath.Join("testdata", "initparm.cfg"))
+	require.NoError(t, err)
+
+	cfg, err := initparm.Parse(string(bs))
+	require.NoError(t, err)
+
+	return string(bs), cfg
+}
+
+func TestParse(t *testing.T) {
+	input, cfg := readConfig(t)
+
+	require.Len(t, cfg.Records(), 9)
+	require.Equal(t, input, cfg.String())
+
+	value, found := cfg.Get("ndm.path", "path")
+	require.True(t, found)
+	require.Equal(t, "/opt/cdunix/ndm", value)
+
+	_, found = cfg.Get("missing", "path")
+	require.False(t, found)
+
+	settings, err := cfg.Settings()
+	require.NoError(t, err)
+	require.Equal(t, initparm.Settings{
+		NodeName:            "cdnode",
+		Path:                "/opt/cdunix/ndm",
+		MaxCompletionCode:   4,
+		ProcessPriority:     10,
+		TCQMaxAge:           8,
+		TCQCheckpointMaxAge: 8,
+		StatsFileSize:       1048576,
+		StatsMaxAge:         8,
+		StatsLogCommands:    true,
+		StatsLogSelect:      false,
+		RestrictCommands:    true,
+		TrustedAddresses:    []string{"10.20.30.40", "10.20.30.41"},
+		FileAgentEnabled:    true,
+	}, settings)
+
+	_, err = initparm.Parse("ndm.node\n")
+	require.EqualError(t, err, `parsing initparm: line 1: expected record name followed by a colon: "ndm.node"`)
+}
+
+func TestConfig_Set(t *testing.T) {
+	input, cfg := readConfig(t)
+
+	cfg.Set("tcq", "max.age", "30")
+	cfg.Set("stats", "stats.exit.program", "/opt/cdunix/ndm/bin/statsexit")
+
+	settings, err := cfg.Settings()
+	require.NoError(t, err)
+	require.Equal(t, 30, settings.TCQMaxAge)
+	require.Equal(t, "/opt/cdunix/ndm/bin/statsexit", settings.StatsExitProgram)
+
+	_, original := readConfig(t)
+	var changes []string
+	for _, change := range initparm.Diff(original, cfg) {
+		changes = append(changes, change.String())
+	}
+	require.Equal(t, []string{
+		"~ tcq max.age: 8 -> 30",
+		"+ stats stats.exit.program=/opt/cdunix/ndm/bin/statsexit",
+	}, changes)
+
+	// Unchanged records keep their layout
+	again, err := initparm.Parse(cfg.String())
+	require.NoError(t, err)
+	require.Equal(t, cfg.String(), again.String())
+	require.Contains(t, cfg.String(), "ndm.path:\\\n :path=/opt/cdunix/ndm:\n")
+	require.NotEqual(t, input, cfg.String())
+
+	cfg.Set("ndm.loglvl", "level", "N")
+	require.Contains(t, cfg.String(), "\nndm.loglvl:\\\n :level=N:\n")
+
+	cfg.Set("stats", "log.select", "maybe")
+	_, err = cfg.Settings()
+	require.EqualError(t, err, `stats log.select: expected y or n, found "maybe"`)
+}
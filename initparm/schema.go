@@ -0,0 +1,142 @@
+package initparm
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Kind is the type of value a parameter accepts.
+type Kind int
+
+const (
+	KindString Kind = iota
+	KindNumber
+	KindBool // y or n
+	KindPath // absolute path
+)
+
+// Param describes a known parameter within a record.
+type Param struct {
+	Record string
+	Key    string
+	Kind   Kind
+
+	// Min and Max limit KindNumber values when Max is non-zero
+	Min, Max int
+
+	// Required parameters must be present in every initparm.cfg
+	Required bool
+}
+
+// params are a partial list of the initparm.cfg parameters, not every parameter Connect:Direct
+// for UNIX accepts. They don't depend on the version, as IBM's documentation doesn't say which
+// version added or removed them. The maintenance notes only say that the default install stopped
+// writing syslog.logd (CDUA-2265, 6.2.0.3), not that the parameter was removed, so it's still listed.
+var params = []Param{
+	{Record: "ccode", Key: "max", Kind: KindNumber, Min: 0, Max: 16},
+	{Record: "ndm.path", Key: "path", Kind: KindPath, Required: true},
+	{Record: "ndm.node", Key: "name", Kind: KindString, Required: true},
+	{Record: "proc.prio", Key: "default", Kind: KindNumber, Min: 1, Max: 15},
+	{Record: "tcq", Key: "max.age", Kind: KindNumber, Min: 0, Max: 9999},
+	{Record: "tcq", Key: "ckpt.max.age", Kind: KindNumber, Min: 0, Max: 9999},
+	{Record: "stats", Key: "file.size", Kind: KindNumber},
+	{Record: "stats", Key: "log.commands", Kind: KindBool},
+	{Record: "stats", Key: "log.select", Kind: KindBool},
+	{Record: "stats", Key: "max.age", Kind: KindNumber, Min: 0, Max: 9999},
+	{Record: "stats", Key: "stats.exit.program", Kind: KindPath},
+	{Record: "restrict", Key: "cmd", Kind: KindBool},
+	{Record: "port.check", Key: "trusted.addr", Kind: KindString},
+	{Record: "cdfa", Key: "cdfa.enable", Kind: KindBool},
+	{Record: "syslog", Key: "syslog.logd", Kind: KindString},
+}
+
+// Schema returns the parameters which are checked by Validate.
+func Schema() []Param {
+	return slices.Clone(params)
+}
+
+func lookupParam(schema []Param, record, key string) (Param, bool) {
+	for _, p := range schema {
+		if strings.EqualFold(p.Record, record) && p.Key == key {
+			return p, true
+		}
+	}
+	return Param{}, false
+}
+
+// Validate checks the config against the schema. Required parameters must be present, known
+// parameters must have valid values and records can't be repeated. Every problem found is
+// returned, joined into one error.
+//
+// Parameters outside the schema are not errors, they're returned by Unlisted.
+func (c *Config) Validate() error {
+	var errs []error
+	for _, p := range params {
+		if value, _ := c.Get(p.Record, p.Key); p.Required && value == "" {
+			errs = append(errs, fmt.Errorf("missing %s %s", p.Record, p.Key))
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, rec := range c.Records() {
+		name := strings.ToLower(rec.Name)
+		if seen[name] {
+			errs = append(errs, fmt.Errorf("duplicate record %s on line %d", rec.Name, rec.Line))
+		}
+		seen[name] = true
+
+		for _, field := range rec.Fields {
+			p, found := lookupParam(params, rec.Name, field.Key)
+			if !found {
+				continue
+			}
+			if err := p.validate(field.Value); err != nil {
+				errs = append(errs, fmt.Errorf("%s %s: %v", rec.Name, field.Key, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (p Param) validate(value string) error {
+	switch p.Kind {
+	case KindNumber:
+		n, err := parseNumber(value)
+		if err != nil {
+			return err
+		}
+		if p.Max > 0 && (n < p.Min || n > p.Max) {
+			return fmt.Errorf("%d is outside %d to %d", n, p.Min, p.Max)
+		}
+		if n < 0 {
+			return fmt.Errorf("%d is negative", n)
+		}
+
+	case KindBool:
+		_, err := parseBool(value)
+		return err
+
+	case KindPath:
+		if !strings.HasPrefix(value, "/") {
+			return fmt.Errorf("%q is not an absolute path", value)
+		}
+	}
+	return nil
+}
+
+// Unlisted returns the parameters, written as "record key", which are not in the schema.
+// The schema is a partial list, so these are often valid parameters (like instance.id) which this
+// package doesn't check, as well as misspelled or removed ones.
+func (c *Config) Unlisted() []string {
+	var out []string
+	for _, rec := range c.Records() {
+		for _, field := range rec.Fields {
+			if _, found := lookupParam(params, rec.Name, field.Key); !found {
+				out = append(out, rec.Name+" "+field.Key)
+			}
+		}
+	}
+	return out
+}
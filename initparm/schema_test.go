@@ -0,0 +1,48 @@
+package initparm_test
+
+import (
+	"testing"
+
+	"github.com/moov-io/go-connect-direct/initparm"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchema(t *testing.T) {
+	schema := initparm.Schema()
+	require.Len(t, schema, 15)
+
+	// syslog.logd is no longer written by the 6.2.0.3 installer, but is still accepted
+	require.Contains(t, schema, initparm.Param{Record: "syslog", Key: "syslog.logd", Kind: initparm.KindString})
+}
+
+func TestValidate(t *testing.T) {
+	_, cfg := readConfig(t)
+
+	require.NoError(t, cfg.Validate())
+	require.Empty(t, cfg.Unlisted())
+}
+
+func TestValidate_Errors(t *testing.T) {
+	cfg, err := initparm.Parse(`ndm.path:path=ndm:
+proc.prio:default=20:
+stats:\
+ :log.commands=sometimes:\
+ :max.age=-1:\
+ :custom=1:
+tcq:max.age=eight:
+tcq:ckpt.max.age=8:
+syslog:syslog.logd=/var/log:
+`)
+	require.NoError(t, err)
+
+	require.EqualError(t, cfg.Validate(), `missing ndm.node name
+ndm.path path: "ndm" is not an absolute path
+proc.prio default: 20 is outside 1 to 15
+stats log.commands: expected y or n, found "sometimes"
+stats max.age: -1 is outside 0 to 9999
+tcq max.age: invalid number "eight"
+duplicate record tcq on line 8`)
+
+	require.Equal(t, []string{"stats custom"}, cfg.Unlisted())
+}
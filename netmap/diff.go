@@ -0,0 +1,71 @@
+package netmap
+
+import (
+	"fmt"
+)
+
+// Change is a difference between two netmaps.
+//
+// Key is empty when a whole node was added or removed. Old is empty for added nodes and fields,
+// New is empty for removed ones.
+type Change struct {
+	Node string
+	Key  string
+	Old  string
+	New  string
+
+	Added   bool
+	Removed bool
+}
+
+func (c Change) String() string {
+	switch {
+	case c.Key == "" && c.Added:
+		return "+ " + c.Node
+	case c.Key == "" && c.Removed:
+		return "- " + c.Node
+	case c.Added:
+		return fmt.Sprintf("+ %s %s=%s", c.Node, c.Key, c.New)
+	case c.Removed:
+		return fmt.Sprintf("- %s %s=%s", c.Node, c.Key, c.Old)
+	}
+	return fmt.Sprintf("~ %s %s: %s -> %s", c.Node, c.Key, c.Old, c.New)
+}
+
+// Diff returns the changes from one netmap to another, such as between environments.
+//
+// Nodes are matched by name without case and fields by key, so comments and layout are ignored.
+// Changes are in the order of the nodes and fields in from, followed by additions in to.
+func Diff(from, to *Netmap) []Change {
+	var out []Change
+
+	for _, rec := range from.Records {
+		other := to.Lookup(rec.Name)
+		if other == nil {
+			out = append(out, Change{Node: rec.Name, Removed: true})
+			continue
+		}
+
+		for _, field := range rec.Fields {
+			value, found := other.Get(field.Key)
+			switch {
+			case !found:
+				out = append(out, Change{Node: rec.Name, Key: field.Key, Old: field.Value, Removed: true})
+			case value != field.Value:
+				out = append(out, Change{Node: rec.Name, Key: field.Key, Old: field.Value, New: value})
+			}
+		}
+		for _, field := range other.Fields {
+			if _, found := rec.Get(field.Key); !found {
+				out = append(out, Change{Node: rec.Name, Key: field.Key, New: field.Value, Added: true})
+			}
+		}
+	}
+
+	for _, rec := range to.Records {
+		if from.Lookup(rec.Name) == nil {
+			out = append(out, Change{Node: rec.Name, Added: true})
+		}
+	}
+	return out
+}
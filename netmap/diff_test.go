@@ -0,0 +1,36 @@
+package netmap_test
+
+import (
+	"testing"
+
+	"github.com/moov-io/go-connect-direct/netmap"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	_, prod := readNetmap(t)
+	_, test := readNetmap(t)
+
+	require.Empty(t, netmap.Diff(prod, test))
+
+	fedach := test.Lookup("fedach-dit")
+	fedach.Set("comm.info", "10.20.30.41;1364")
+	fedach.Delete("descrip")
+	fedach.Set("sess.default", "1")
+	test.Lookup("partner01").Set("contact.name", "Partner")
+	test.Remove("local.node")
+	test.Add(&netmap.Record{Name: "partner02"})
+
+	var lines []string
+	for _, change := range netmap.Diff(prod, test) {
+		lines = append(lines, change.String())
+	}
+	require.Equal(t, []string{
+		"- local.node",
+		"~ fedach-dit comm.info: 10.20.30.40;1364 -> 10.20.30.41;1364",
+		"- fedach-dit descrip=FedACH DIT environment",
+		"+ partner01 contact.name=Partner",
+		"+ partner02",
+	}, lines)
+}
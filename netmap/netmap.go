@@ -0,0 +1,252 @@
+// Package netmap reads and writes Connect:Direct for UNIX netmap.cfg files.
+//
+// A netmap is a list of records, each a node name followed by colon separated "key=value" fields
+// which continue onto following lines with a trailing backslash:
+//
+//	local.node:\
+//	 :comm.info=0.0.0.0;1364:\
+//	 :tcp.api=cdnode;1363:\
+//	 :sess.total=255:
+//
+// Comments start with # and are kept, along with the original layout of records which are not changed,
+// so a parsed netmap is written back exactly as it was read.
+package netmap
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// LocalNodeName is the record which configures the local Connect:Direct node.
+const LocalNodeName = "local.node"
+
+type Netmap struct {
+	Records []*Record
+
+	// Trailer holds the comment and blank lines after the last record
+	Trailer []string
+
+	// noNewline is set when the parsed input did not end with a newline
+	noNewline bool
+}
+
+// Record is one node entry in a netmap.
+type Record struct {
+	Name   string
+	Fields []Field
+
+	// Comments holds the comment and blank lines before the record
+	Comments []string
+
+	// Line is where the record starts in the parsed input, or zero for new records
+	Line int
+
+	// raw and parsed are the original lines and fields, which are written again when Fields is unchanged
+	raw    []string
+	parsed []Field
+}
+
+type Field struct {
+	Key   string
+	Value string
+}
+
+// Parse reads a netmap.cfg file.
+func Parse(input string) (*Netmap, error) {
+	out := &Netmap{
+		noNewline: !strings.HasSuffix(input, "\n"),
+	}
+
+	lines := strings.Split(strings.TrimSuffix(input, "\n"), "\n")
+	if input == "" {
+		lines = nil
+	}
+
+	var comments []string
+	var current *Record
+	for idx, line := range lines {
+		trimmed := strings.TrimSpace(line)
+
+		if current != nil {
+			// Comments within a record are kept in its layout, a blank line ends it
+			if strings.HasPrefix(trimmed, "#") {
+				current.raw = append(current.raw, line)
+				continue
+			}
+			if trimmed != "" {
+				current.raw = append(current.raw, line)
+				if err := current.parseFields(trimmed); err != nil {
+					return nil, fmt.Errorf("line %d: %v", idx+1, err)
+				}
+				if !strings.HasSuffix(trimmed, `\`) {
+					current = nil
+				}
+				continue
+			}
+			current = nil
+		}
+
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			comments = append(comments, line)
+			continue
+		}
+
+		name, rest, found := strings.Cut(trimmed, ":")
+		if !found || name == "" {
+			return nil, fmt.Errorf("line %d: expected record name followed by a colon: %q", idx+1, line)
+		}
+		rec := &Record{
+			Name:     strings.TrimSpace(name),
+			Comments: comments,
+			Line:     idx + 1,
+			raw:      []string{line},
+		}
+		comments = nil
+		if err := rec.parseFields(rest); err != nil {
+			return nil, fmt.Errorf("line %d: %v", idx+1, err)
+		}
+		out.Records = append(out.Records, rec)
+
+		if strings.HasSuffix(trimmed, `\`) {
+			current = rec
+		}
+	}
+	out.Trailer = comments
+
+	for _, rec := range out.Records {
+		rec.parsed = slices.Clone(rec.Fields)
+	}
+	return out, nil
+}
+
+// parseFields reads the fields from one line of a record, such as ":sess.total=8:sess.pnode.max=4:\"
+//
+// Segments without an equals sign are joined to the previous value, which keeps colons within values
+// such as IPv6 addresses in comm.info.
+func (r *Record) parseFields(line string) error {
+	line = strings.TrimSpace(strings.TrimSuffix(line, `\`))
+	line = strings.TrimPrefix(line, ":")
+	line = strings.TrimSuffix(line, ":")
+	if line == "" {
+		return nil
+	}
+
+	var fields []Field
+	for _, segment := range strings.Split(line, ":") {
+		key, value, found := strings.Cut(segment, "=")
+		switch {
+		case found:
+			fields = append(fields, Field{Key: strings.TrimSpace(key), Value: value})
+		case len(fields) > 0:
+			fields[len(fields)-1].Value += ":" + segment
+		case segment != "":
+			return fmt.Errorf("expected key=value in %q", segment)
+		}
+	}
+	for _, field := range fields {
+		if field.Key == "" {
+			return fmt.Errorf("missing key in %q", line)
+		}
+	}
+	r.Fields = append(r.Fields, fields...)
+	return nil
+}
+
+// Lookup returns the record with a matching name, compared without case.
+func (n *Netmap) Lookup(name string) *Record {
+	for _, rec := range n.Records {
+		if strings.EqualFold(rec.Name, name) {
+			return rec
+		}
+	}
+	return nil
+}
+
+// Local returns the local.node record, or nil when there isn't one.
+func (n *Netmap) Local() *Record {
+	return n.Lookup(LocalNodeName)
+}
+
+// Add appends a record, which is written in the standard layout.
+func (n *Netmap) Add(rec *Record) {
+	n.Records = append(n.Records, rec)
+}
+
+// Remove deletes the record with a matching name and returns true if it was found.
+func (n *Netmap) Remove(name string) bool {
+	for i, rec := range n.Records {
+		if strings.EqualFold(rec.Name, name) {
+			n.Records = slices.Delete(n.Records, i, i+1)
+			return true
+		}
+	}
+	return false
+}
+
+// Get returns the first value of a field, such as "comm.info".
+func (r *Record) Get(key string) (string, bool) {
+	for _, field := range r.Fields {
+		if field.Key == key {
+			return field.Value, true
+		}
+	}
+	return "", false
+}
+
+// Set replaces the value of a field or appends it when not found.
+func (r *Record) Set(key, value string) {
+	for i := range r.Fields {
+		if r.Fields[i].Key == key {
+			r.Fields[i].Value = value
+			return
+		}
+	}
+	r.Fields = append(r.Fields, Field{Key: key, Value: value})
+}
+
+// Delete removes every field with a matching key.
+func (r *Record) Delete(key string) {
+	r.Fields = slices.DeleteFunc(r.Fields, func(f Field) bool {
+		return f.Key == key
+	})
+}
+
+// String returns the netmap as it would be written to netmap.cfg.
+func (n *Netmap) String() string {
+	var lines []string
+	for _, rec := range n.Records {
+		lines = append(lines, rec.Comments...)
+		lines = append(lines, rec.lines()...)
+	}
+	lines = append(lines, n.Trailer...)
+
+	out := strings.Join(lines, "\n")
+	if n.noNewline {
+		return out
+	}
+	return out + "\n"
+}
+
+// lines returns the original layout of a record when its fields are unchanged,
+// otherwise each field on its own line.
+func (r *Record) lines() []string {
+	if r.raw != nil && r.Name == r.parsedName() && slices.Equal(r.Fields, r.parsed) {
+		return r.raw
+	}
+
+	out := []string{r.Name + ":"}
+	for _, field := range r.Fields {
+		out[len(out)-1] += `\`
+		out = append(out, " :"+field.Key+"="+field.Value+":")
+	}
+	return out
+}
+
+func (r *Record) parsedName() string {
+	if len(r.raw) == 0 {
+		return ""
+	}
+	name, _, _ := strings.Cut(strings.TrimSpace(r.raw[0]), ":")
+	return strings.TrimSpace(name)
+}
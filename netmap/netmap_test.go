@@ -0,0 +1,132 @@
+package netmap_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/go-connect-direct/netmap"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readNetmap(t *testing.T) (string, *netmap.Netmap) {
+	t.Helper()
+
+	bs, err := os.ReadFile(filepath.Join("testdata", "netmap.cfg"))
+	require.NoError(t, err)
+
+	nm, err := netmap.Parse(string(bs))
+	require.NoError(t, err)
+
+	return string(bs), nm
+}
+
+func TestParse(t *testing.T) {
+	input, nm := readNetmap(t)
+
+	require.Len(t, nm.Records, 3)
+	require.Equal(t, input, nm.String())
+
+	local := nm.Local()
+	require.NotNil(t, local)
+	require.Equal(t, 5, local.Line)
+	require.Equal(t, []string{
+		"# Connect:Direct netmap for the ACH gateway",
+		"#",
+		"# Updated 02/03/2026",
+		"",
+	}, local.Comments)
+
+	value, found := local.Get("tcp.api")
+	require.True(t, found)
+	require.Equal(t, "cdnode;1363", value)
+
+	fedach := nm.Lookup("FEDACH-DIT")
+	require.NotNil(t, fedach)
+	require.Len(t, fedach.Fields, 10)
+	require.Equal(t, netmap.Field{Key: "sess.pnode.max", Value: "4"}, fedach.Fields[5])
+
+	partner := nm.Lookup("partner01")
+	value, _ = partner.Get("comm.info")
+	require.Equal(t, "fd00::10;1364", value)
+
+	require.Empty(t, nm.Trailer)
+	require.NoError(t, nm.Validate())
+}
+
+func TestParse_Errors(t *testing.T) {
+	_, err := netmap.Parse("local.node:\\\n :comm.info:")
+	require.EqualError(t, err, `line 2: expected key=value in "comm.info"`)
+
+	_, err = netmap.Parse("# comment\nno colon here\n")
+	require.EqualError(t, err, `line 2: expected record name followed by a colon: "no colon here"`)
+
+	_, err = netmap.Parse("node:\\\n :=value:")
+	require.EqualError(t, err, `line 2: missing key in "=value"`)
+}
+
+func TestNetmap_Write(t *testing.T) {
+	input, nm := readNetmap(t)
+
+	partner := nm.Lookup("partner01")
+	partner.Set("sess.total", "4")
+	partner.Set("contact.name", "Partner Ops")
+	partner.Delete("runstep.max.signon.default")
+
+	nm.Add(&netmap.Record{
+		Name: "partner02",
+		Fields: []netmap.Field{
+			{Key: "comm.info", Value: "partner02.example.com;1364"},
+			{Key: "sess.total", Value: "2"},
+		},
+		Comments: []string{"", "# Added for testing"},
+	})
+	require.True(t, nm.Remove("FEDACH-DIT"))
+	require.False(t, nm.Remove("missing"))
+
+	expected := input[:len(input)-len(`
+# Federal Reserve FedACH
+fedach-dit:\
+ :conn.retry.stwait=00.00.30:\
+ :conn.retry.stattempts=3:\
+ :comm.info=10.20.30.40;1364:\
+ :comm.transport=tcp:\
+ :sess.total=8:sess.pnode.max=4:sess.snode.max=4:\
+ :sess.default=1:\
+ :contact.name=FedACH Support:\
+ :descrip=FedACH DIT environment:
+
+partner01:\
+ :comm.info=fd00::10;1364:\
+ :sess.total=2:\
+ :runstep.max.signon.default=n:
+`)] + `
+partner01:\
+ :comm.info=fd00::10;1364:\
+ :sess.total=4:\
+ :contact.name=Partner Ops:
+
+# Added for testing
+partner02:\
+ :comm.info=partner02.example.com;1364:\
+ :sess.total=2:
+`
+	require.Equal(t, expected, nm.String())
+
+	again, err := netmap.Parse(nm.String())
+	require.NoError(t, err)
+	require.Equal(t, nm.String(), again.String())
+}
+
+func TestNetmap_NoNewline(t *testing.T) {
+	input := "# comment\nlocal.node:comm.info=0.0.0.0;1364:tcp.api=cdnode;1363:"
+	nm, err := netmap.Parse(input)
+	require.NoError(t, err)
+	require.Equal(t, input, nm.String())
+	require.Len(t, nm.Local().Fields, 2)
+
+	empty, err := netmap.Parse("")
+	require.NoError(t, err)
+	require.Equal(t, "", empty.String())
+}
@@ -0,0 +1,209 @@
+package netmap
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Node is the typed form of a netmap record.
+type Node struct {
+	Name  string
+	Local bool
+
+	// CommInfo is the address of a remote node, or the listening address of the local node (comm.info)
+	CommInfo  Address
+	Transport string
+
+	// API is the address CLI and API clients connect to, set on the local node (tcp.api)
+	API Address
+
+	SessionsTotal    int // sess.total
+	SessionsPNodeMax int // sess.pnode.max
+	SessionsSNodeMax int // sess.snode.max
+	SessionsDefault  int // sess.default
+
+	// RunStepMaxSignonDefault allows run task and run job steps to use the default signon (runstep.max.signon.default)
+	RunStepMaxSignonDefault bool
+
+	ContactName  string
+	ContactPhone string
+	Description  string
+
+	// Fields contains every field of the record, including those without a typed field above.
+	// Only the first value of repeated keys is kept.
+	Fields map[string]string
+}
+
+// Address is a host and port written as "host;port", such as "10.20.30.40;1364".
+type Address struct {
+	Host string
+	Port int
+}
+
+func (a Address) String() string {
+	if a.Port == 0 {
+		return a.Host
+	}
+	return fmt.Sprintf("%s;%d", a.Host, a.Port)
+}
+
+// ParseAddress reads a "host;port" value. The port is optional.
+func ParseAddress(value string) (Address, error) {
+	host, port, found := strings.Cut(strings.TrimSpace(value), ";")
+	out := Address{Host: strings.TrimSpace(host)}
+	if out.Host == "" {
+		return out, fmt.Errorf("missing host in %q", value)
+	}
+	if found {
+		n, err := strconv.Atoi(strings.TrimSpace(port))
+		if err != nil || n <= 0 || n > 65535 {
+			return out, fmt.Errorf("invalid port in %q", value)
+		}
+		out.Port = n
+	}
+	return out, nil
+}
+
+// Node returns the typed form of a record. Blank values are treated as unset.
+func (r *Record) Node() (Node, error) {
+	out := Node{
+		Name:   r.Name,
+		Local:  strings.EqualFold(r.Name, LocalNodeName),
+		Fields: make(map[string]string),
+	}
+	for _, field := range r.Fields {
+		if _, exists := out.Fields[field.Key]; !exists {
+			out.Fields[field.Key] = field.Value
+		}
+	}
+
+	var errs []error
+	address := func(key string, dest *Address) {
+		if value := out.Fields[key]; value != "" {
+			addr, err := ParseAddress(value)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %v", key, err))
+			}
+			*dest = addr
+		}
+	}
+	number := func(key string, dest *int) {
+		if value := out.Fields[key]; value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 {
+				errs = append(errs, fmt.Errorf("%s: invalid number %q", key, value))
+			}
+			*dest = n
+		}
+	}
+
+	address("comm.info", &out.CommInfo)
+	address("tcp.api", &out.API)
+	number("sess.total", &out.SessionsTotal)
+	number("sess.pnode.max", &out.SessionsPNodeMax)
+	number("sess.snode.max", &out.SessionsSNodeMax)
+	number("sess.default", &out.SessionsDefault)
+
+	switch value := strings.ToLower(out.Fields["runstep.max.signon.default"]); value {
+	case "", "n", "no":
+	case "y", "yes":
+		out.RunStepMaxSignonDefault = true
+	default:
+		errs = append(errs, fmt.Errorf("runstep.max.signon.default: expected y or n, found %q", value))
+	}
+
+	out.Transport = out.Fields["comm.transport"]
+	out.ContactName = out.Fields["contact.name"]
+	out.ContactPhone = out.Fields["contact.phone"]
+	out.Description = out.Fields["descrip"]
+
+	if len(errs) > 0 {
+		return out, fmt.Errorf("node %s: %w", r.Name, errors.Join(errs...))
+	}
+	return out, nil
+}
+
+// Nodes returns the typed form of every record.
+func (n *Netmap) Nodes() ([]Node, error) {
+	out := make([]Node, 0, len(n.Records))
+	for _, rec := range n.Records {
+		node, err := rec.Node()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, node)
+	}
+	return out, nil
+}
+
+var (
+	// localRequired are the fields the local.node record needs to accept sessions and API connections
+	localRequired = []string{"comm.info", "tcp.api"}
+
+	// remoteRequired are the fields a remote node needs for outbound sessions
+	remoteRequired = []string{"comm.info"}
+)
+
+// Validate checks for a local.node record, duplicate records and fields, missing required fields
+// and values which can't be read. Every problem found is returned, joined into one error.
+func (n *Netmap) Validate() error {
+	var errs []error
+
+	if n.Local() == nil {
+		errs = append(errs, errors.New("missing local.node record"))
+	}
+
+	seen := make(map[string]*Record)
+	for _, rec := range n.Records {
+		name := strings.ToLower(rec.Name)
+		if prev, exists := seen[name]; exists {
+			errs = append(errs, fmt.Errorf("%s: duplicate node, first defined on line %d", rec.label(), prev.Line))
+		} else {
+			seen[name] = rec
+		}
+
+		keys := make(map[string]bool)
+		for _, field := range rec.Fields {
+			if keys[field.Key] {
+				errs = append(errs, fmt.Errorf("%s: duplicate field %s", rec.label(), field.Key))
+			}
+			keys[field.Key] = true
+		}
+
+		required := remoteRequired
+		if strings.EqualFold(rec.Name, LocalNodeName) {
+			required = localRequired
+		}
+		for _, key := range required {
+			if value, _ := rec.Get(key); value == "" {
+				errs = append(errs, fmt.Errorf("%s: missing %s", rec.label(), key))
+			}
+		}
+
+		node, err := rec.Node()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if node.SessionsTotal > 0 {
+			if node.SessionsPNodeMax > node.SessionsTotal {
+				errs = append(errs, fmt.Errorf("%s: sess.pnode.max %d exceeds sess.total %d", rec.label(), node.SessionsPNodeMax, node.SessionsTotal))
+			}
+			if node.SessionsSNodeMax > node.SessionsTotal {
+				errs = append(errs, fmt.Errorf("%s: sess.snode.max %d exceeds sess.total %d", rec.label(), node.SessionsSNodeMax, node.SessionsTotal))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// label names a record in errors, with its line number when it was parsed
+func (r *Record) label() string {
+	if r.Line > 0 {
+		return fmt.Sprintf("node %s (line %d)", r.Name, r.Line)
+	}
+	return "node " + r.Name
+}
@@ -0,0 +1,79 @@
+package netmap_test
+
+import (
+	"testing"
+
+	"github.com/moov-io/go-connect-direct/netmap"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodes(t *testing.T) {
+	_, nm := readNetmap(t)
+
+	nodes, err := nm.Nodes()
+	require.NoError(t, err)
+	require.Len(t, nodes, 3)
+
+	local := nodes[0]
+	require.True(t, local.Local)
+	require.Equal(t, netmap.Address{Host: "0.0.0.0", Port: 1364}, local.CommInfo)
+	require.Equal(t, netmap.Address{Host: "cdnode", Port: 1363}, local.API)
+	require.Equal(t, 255, local.SessionsTotal)
+	require.True(t, local.RunStepMaxSignonDefault)
+	require.Equal(t, "Payments Ops", local.ContactName)
+	require.Equal(t, "555-0100", local.ContactPhone)
+	require.Equal(t, "ACH gateway", local.Description)
+
+	fedach := nodes[1]
+	require.False(t, fedach.Local)
+	require.Equal(t, "10.20.30.40;1364", fedach.CommInfo.String())
+	require.Equal(t, "tcp", fedach.Transport)
+	require.Equal(t, 8, fedach.SessionsTotal)
+	require.Equal(t, 4, fedach.SessionsPNodeMax)
+	require.Equal(t, 4, fedach.SessionsSNodeMax)
+	require.Equal(t, 1, fedach.SessionsDefault)
+	require.Equal(t, "3", fedach.Fields["conn.retry.stattempts"])
+
+	partner := nodes[2]
+	require.Equal(t, netmap.Address{Host: "fd00::10", Port: 1364}, partner.CommInfo)
+	require.False(t, partner.RunStepMaxSignonDefault)
+}
+
+func TestParseAddress(t *testing.T) {
+	addr, err := netmap.ParseAddress("cdnode")
+	require.NoError(t, err)
+	require.Equal(t, "cdnode", addr.String())
+
+	_, err = netmap.ParseAddress(";1364")
+	require.EqualError(t, err, `missing host in ";1364"`)
+
+	_, err = netmap.ParseAddress("cdnode;99999")
+	require.EqualError(t, err, `invalid port in "cdnode;99999"`)
+}
+
+func TestValidate(t *testing.T) {
+	nm, err := netmap.Parse(`remote01:\
+ :comm.info=10.0.0.1;1364:\
+ :sess.total=2:\
+ :sess.pnode.max=4:\
+ :sess.total=3:
+REMOTE01:\
+ :comm.info=10.0.0.2;1364:
+remote02:\
+ :contact.name=nobody:\
+ :runstep.max.signon.default=maybe:
+`)
+	require.NoError(t, err)
+
+	err = nm.Validate()
+	require.EqualError(t, err, `missing local.node record
+node remote01 (line 1): duplicate field sess.total
+node remote01 (line 1): sess.pnode.max 4 exceeds sess.total 2
+node REMOTE01 (line 6): duplicate node, first defined on line 1
+node remote02 (line 8): missing comm.info
+node remote02: runstep.max.signon.default: expected y or n, found "maybe"`)
+
+	_, err = nm.Nodes()
+	require.EqualError(t, err, `node remote02: runstep.max.signon.default: expected y or n, found "maybe"`)
+}
@@ -39,8 +39,15 @@ type SummaryStat struct {
 	Date          time.Time
 	Description   string
 	ProcessNumber string
+	StepName      string
 	Code          int
+	FeedbackCode  int
 	MessageID     string
+
+	// External (X) records include the application which wrote the record
+	ApplicationDescription string
+	UserID                 string
+	NodeName               string
 }
 
 var (
@@ -68,7 +75,14 @@ var (
 //	E SUBP  02/03/2026 23:28:45 Submit command issued.
 //	P PSTR  02/03/2026 23:28:45 sample            14                0      XSMG200I
 //
-// The function handles special cases for submit processes (SUBP) and general records using LookupRecordID.
+// Columns are sliced at the offsets of the header lines, such as:
+//
+//	P RECID LOG TIME            PNAME        PNUMBER  STEPNAME   CCOD FDBK MSGID
+//
+// so blank STEPNAME and FDBK columns and process names with spaces are read correctly. Without a header,
+// or for lines which don't follow it, columns are split on whitespace instead.
+//
+// The function handles special cases for submit processes (SUBP) and general records using the Registry from opts, or DefaultRegistry.
 // Dates are parsed in the format "01/02/2006 15:04:05".
 //
 // Statistics can be viewed in Connect:Direct with commands like:
@@ -76,101 +90,73 @@ var (
 //	sel stat ccode(ge,0) pnumber=18;
 //
 // If the input is malformed (e.g., invalid date, insufficient columns, or unparseable codes), an error is returned.
-func ParseCCode(input string) (SummaryStats, error) {
+//
+// Use NewScanner to read large outputs without holding every record in memory.
+func ParseCCode(input string, opts ...Options) (SummaryStats, error) {
 	var out SummaryStats
 
-	var err error
-	lines := strings.Split(input, "\n")
-
-	// Find the row with a bunch of hyphens
-	var shouldParseLine bool
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
+	scanner := NewScanner(strings.NewReader(input), opts...)
+	for scanner.Scan() {
+		out.Stats = append(out.Stats, scanner.Stat())
+	}
+	return out, scanner.Err()
+}
 
-		if strings.Contains(line, "------") {
-			shouldParseLine = true
-		}
-		// Stop when we see a line of equals again
-		if shouldParseLine && strings.Contains(line, "======") {
-			break
+// parseSummaryLine parses one line of summary output, returning nil when the line isn't a record.
+// Columns are sliced using the layout of the record type's header line when one was found.
+func parseSummaryLine(line string, cols []string, layout *summaryLayout, registry *Registry) (*SummaryStat, error) {
+	switch strings.ToUpper(cols[1]) {
+	case SubmitProcess.ID:
+		if len(cols) < 4 {
+			return nil, nil
 		}
 
-		// Skip lines when we aren't actively parsing
-		if !shouldParseLine {
-			continue
+		// Parse a submit process line
+		rec := &SummaryStat{
+			Type:        cols[0],
+			ID:          SubmitProcess,
+			Description: strings.Join(cols[4:], " "),
 		}
-
-		// Parse the line
-		// We've seen lines like the following:
-		//   E SUBP  02/03/2026 23:28:45 Submit command issued.
-		//   P PSTR  02/03/2026 23:28:45 sample            14                0      XSMG200I
-
-		cols := strings.Fields(line)
-		if len(cols) < 2 {
-			continue // invalid line
+		date, err := parseSummaryDate(cols[2:4])
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s date: %v", SubmitProcess.ID, err)
 		}
-
-		switch strings.ToUpper(cols[1]) {
-		case SubmitProcess.ID:
-			if len(cols) < 4 {
-				continue
+		rec.Date = date
+		return rec, nil
+
+	default:
+		// Parse a line which looks like:
+		// P RECID LOG TIME            PNAME        PNUMBER  STEPNAME   CCOD FDBK MSGID
+		// E RECID LOG TIME            MESSAGE TEXT
+		// X RECID LOG TIME            APP DESC     USID     NODENAME   CCOD MSGID
+
+		switch cols[0] {
+		case "P": // process
+			rec, err := parseSummaryProcessRecord(line, cols, layout, registry)
+			if err != nil {
+				return nil, fmt.Errorf("parsing process record: %v", err)
 			}
+			return rec, nil
 
-			// Parse a submit process line
-			rec := SummaryStat{
-				Type:        cols[0],
-				ID:          SubmitProcess,
-				Description: strings.Join(cols[4:], " "),
-			}
-			rec.Date, err = parseSummaryDate(cols[2:4])
+		case "E": // error
+			rec, err := parseSummaryErrorRecord(cols, registry)
 			if err != nil {
-				return out, fmt.Errorf("parsing %s date: %v", SubmitProcess.ID, err)
+				return nil, fmt.Errorf("parsing error record: %v", err)
 			}
-			out.Stats = append(out.Stats, rec)
-
-		default:
-			// Parse a line which looks like:
-			// P RECID LOG TIME            PNAME        PNUMBER  STEPNAME   CCOD FDBK MSGID
-			// E RECID LOG TIME            MESSAGE TEXT
-			// X RECID LOG TIME            APP DESC     USID     NODENAME   CCOD MSGID
-
-			switch cols[0] {
-			case "P": // process
-				rec, err := parseSummaryProcessRecord(cols)
-				if err != nil {
-					return out, fmt.Errorf("parsing process record: %v", err)
-				}
-				if rec != nil {
-					out.Stats = append(out.Stats, *rec)
-				}
-
-			case "E": // error
-				rec, err := parseSummaryErrorRecord(cols)
-				if err != nil {
-					return out, fmt.Errorf("parsing error record: %v", err)
-				}
-				if rec != nil {
-					out.Stats = append(out.Stats, *rec)
-				}
-
-			case "X": // xtra records
-				rec, err := parseSummaryExtraRecord(cols)
-				if err != nil {
-					return out, fmt.Errorf("parsing extra record: %v", err)
-				}
-				if rec != nil {
-					out.Stats = append(out.Stats, *rec)
-				}
+			return rec, nil
 
+		case "X": // xtra records
+			rec, err := parseSummaryExtraRecord(line, cols, layout, registry)
+			if err != nil {
+				return nil, fmt.Errorf("parsing extra record: %v", err)
 			}
-
+			return rec, nil
 		}
 	}
-
-	return out, nil
+	return nil, nil
 }
 
-func parseSummaryProcessRecord(cols []string) (*SummaryStat, error) {
+func parseSummaryProcessRecord(line string, cols []string, layout *summaryLayout, registry *Registry) (*SummaryStat, error) {
 	// example records
 	//
 	//   P PSTR  02/03/2026 23:28:45 sample            14                0      XSMG200I
@@ -179,12 +165,16 @@ func parseSummaryProcessRecord(cols []string) (*SummaryStat, error) {
 
 	rec := &SummaryStat{}
 
-	if len(cols) < 8 {
+	var fields map[string]string
+	if layout != nil {
+		fields, _ = layout.fields(line)
+	}
+	if len(cols) < 4 {
 		return nil, nil
 	}
 	rec.Type = cols[0]
 
-	ccode := LookupRecordID(cols[1])
+	ccode := registry.Lookup(cols[1])
 	if ccode == nil {
 		ccode = &RecordID{
 			ID: strings.ToUpper(cols[1]),
@@ -198,38 +188,74 @@ func parseSummaryProcessRecord(cols []string) (*SummaryStat, error) {
 	}
 	rec.Date = date
 
-	// Start adding columns right to left
-	idx := len(cols) - 1
-	if len(cols) > idx {
-		rec.MessageID = cols[idx]
-	}
-	idx--
+	if fields != nil {
+		rec.Description = fields["PNAME"]
+		rec.ProcessNumber = fields["PNUMBER"]
+		rec.StepName = fields["STEPNAME"]
+		rec.MessageID = fields["MSGID"]
 
-	if len(cols) > idx {
-		cc, err := strconv.ParseInt(cols[idx], 10, 16)
+		rec.Code, err = parseSummaryCode(fields["CCOD"], 16)
+		if err != nil {
+			return rec, err
+		}
+		rec.FeedbackCode, err = parseSummaryCode(fields["FDBK"], 32)
 		if err != nil {
 			return rec, err
 		}
-		rec.Code = int(cc)
+		return rec, nil
 	}
-	idx--
 
-	if len(cols) > 8 {
-		idx-- // skip NODENAME
+	// Without a header the columns are split on whitespace, which only works for a one word PNAME.
+	// STEPNAME and FDBK are blank for most records, so each adds a column when it's written
+	//
+	//   P PSTR  02/03/2026 23:28:45 sample            14                0      XSMG200I
+	//   P XCPS  02/03/2026 23:26:40 sample            13                8    2 XCPS002I
+	//   P CTRC  02/03/2026 23:26:40 sample            13  step01        8    2 XCPS002I
+	//
+	// With 9 columns the one after PNUMBER is a FDBK when it's a number, otherwise a STEPNAME.
+	// Other rows, like those with a PNAME of several words, can't be split and are skipped.
+	var hasStep, hasFeedback bool
+	switch len(cols) {
+	case 8:
+	case 9:
+		hasFeedback = isSummaryNumber(cols[6])
+		hasStep = !hasFeedback
+	case 10:
+		hasStep, hasFeedback = true, true
+	default:
+		return nil, nil
 	}
+	if !isSummaryNumber(cols[5]) {
+		return nil, nil
+	}
+	rec.Description = cols[4]
+	rec.ProcessNumber = cols[5]
 
-	if len(cols) > idx {
-		rec.ProcessNumber = cols[idx]
+	idx := 6
+	if hasStep {
+		rec.StepName = cols[idx]
+		idx++
 	}
 
-	if len(cols) > idx {
-		rec.Description = strings.Join(cols[4:idx], " ")
+	rec.Code, err = parseSummaryCode(cols[idx], 16)
+	if err != nil {
+		return rec, err
 	}
+	idx++
+
+	if hasFeedback {
+		rec.FeedbackCode, err = parseSummaryCode(cols[idx], 32)
+		if err != nil {
+			return rec, err
+		}
+		idx++
+	}
+	rec.MessageID = cols[idx]
 
 	return rec, nil
 }
 
-func parseSummaryErrorRecord(cols []string) (*SummaryStat, error) {
+func parseSummaryErrorRecord(cols []string, registry *Registry) (*SummaryStat, error) {
 	// example records
 	//
 	//    E RNCF  02/05/2026 22:45:40 Attempt to connect to remote node frbpajcd02 failed
@@ -241,7 +267,7 @@ func parseSummaryErrorRecord(cols []string) (*SummaryStat, error) {
 	}
 	rec.Type = cols[0]
 
-	ccode := LookupRecordID(cols[1])
+	ccode := registry.Lookup(cols[1])
 	if ccode == nil {
 		ccode = &RecordID{
 			ID: strings.ToUpper(cols[1]),
@@ -262,8 +288,96 @@ func parseSummaryErrorRecord(cols []string) (*SummaryStat, error) {
 	return rec, nil
 }
 
-func parseSummaryExtraRecord(cols []string) (*SummaryStat, error) {
-	return nil, nil // TODO(adam):
+func parseSummaryExtraRecord(line string, cols []string, layout *summaryLayout, registry *Registry) (*SummaryStat, error) {
+	// example records
+	//
+	//   X EXFA  02/06/2026 14:02:11 IFA          cdadmin  cdnode        0 XIFA000I
+
+	rec := &SummaryStat{}
+
+	var fields map[string]string
+	if layout != nil {
+		fields, _ = layout.fields(line)
+	}
+	if len(cols) < 4 || (len(cols) < 9 && fields == nil) {
+		return nil, nil
+	}
+	rec.Type = cols[0]
+
+	ccode := registry.Lookup(cols[1])
+	if ccode == nil {
+		ccode = &RecordID{
+			ID: strings.ToUpper(cols[1]),
+		}
+	}
+	rec.ID = *ccode
+
+	date, err := parseSummaryDate(cols[2:4])
+	if err != nil {
+		return rec, fmt.Errorf("parsing %s date: %v", rec.ID.ID, err)
+	}
+	rec.Date = date
+
+	if fields != nil {
+		rec.ApplicationDescription = fields["APP DESC"]
+		rec.UserID = fields["USID"]
+		rec.NodeName = fields["NODENAME"]
+		rec.MessageID = fields["MSGID"]
+
+		rec.Code, err = parseSummaryCode(fields["CCOD"], 16)
+		if err != nil {
+			return rec, err
+		}
+		return rec, nil
+	}
+
+	// Start adding columns right to left
+	idx := len(cols) - 1
+	rec.MessageID = cols[idx]
+	idx--
+
+	cc, err := strconv.ParseInt(cols[idx], 10, 16)
+	if err != nil {
+		return rec, err
+	}
+	rec.Code = int(cc)
+	idx--
+
+	rec.NodeName = cols[idx]
+	idx--
+
+	rec.UserID = cols[idx]
+
+	rec.ApplicationDescription = strings.Join(cols[4:idx], " ")
+
+	return rec, nil
+}
+
+// parseSummaryCode reads a numeric column, which is 0 when blank
+func parseSummaryCode(value string, bitSize int) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(value, 10, bitSize)
+	return int(n), err
+}
+
+func isSummaryNumber(col string) bool {
+	_, err := strconv.Atoi(col)
+	return err == nil
+}
+
+// summaryTextColumn returns the offset where text begins after the LOG TIME columns of a line.
+func summaryTextColumn(line string, cols []string) int {
+	if len(cols) < 4 {
+		return 0
+	}
+
+	idx := 0
+	for _, c := range cols[:4] {
+		idx += strings.Index(line[idx:], c) + len(c)
+	}
+	return idx + len(line[idx:]) - len(strings.TrimLeft(line[idx:], " \t"))
 }
 
 func parseSummaryDate(fields []string) (time.Time, error) {
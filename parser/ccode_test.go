@@ -59,6 +59,7 @@ func TestParseCCode(t *testing.T) {
 					Date:          time.Date(2026, time.February, 3, 23, 28, 52, 0, time.UTC),
 					Description:   "sample",
 					ProcessNumber: "14",
+					StepName:      "step01",
 					Code:          0,
 					MessageID:     "SCPA000I",
 				},
@@ -68,6 +69,7 @@ func TestParseCCode(t *testing.T) {
 					Date:          time.Date(2026, time.February, 3, 23, 28, 52, 0, time.UTC),
 					Description:   "sample",
 					ProcessNumber: "14",
+					StepName:      "step01",
 					Code:          0,
 					MessageID:     "SCPA000I",
 				},
@@ -94,21 +96,31 @@ func TestParseCCode(t *testing.T) {
 		{
 			inputFilepath: filepath.Join("testdata", "ccode_error.txt"),
 			expected: []parser.SummaryStat{
-				{Type: "P", ID: parser.RecordID{ID: "XIPT"}, Date: time.Date(2026, time.February, 5, 22, 45, 40, 0, time.UTC), Description: "SENDFILE", ProcessNumber: "21", Code: 8, MessageID: "XIPT004I"},
-				{Type: "E", ID: parser.RecordID{ID: "RNCF"}, Date: time.Date(2026, time.February, 5, 22, 45, 40, 0, time.UTC), Description: "Attempt to connect to remote node frbpajcd02 failed"},
-				{Type: "P", ID: parser.RecordID{ID: "XIPT"}, Date: time.Date(2026, time.February, 5, 22, 46, 10, 0, time.UTC), Description: "SENDFILE", ProcessNumber: "21", Code: 8, MessageID: "XIPT004I"},
-				{Type: "E", ID: parser.RecordID{ID: "RNCF"}, Date: time.Date(2026, time.February, 5, 22, 46, 10, 0, time.UTC), Description: "Attempt to connect to remote node frbpajcd02 failed"},
-				{Type: "P", ID: parser.RecordID{ID: "XIPT"}, Date: time.Date(2026, time.February, 5, 22, 46, 40, 0, time.UTC), Description: "SENDFILE", ProcessNumber: "21", Code: 8, MessageID: "XIPT004I"},
-				{Type: "E", ID: parser.RecordID{ID: "RNCF"}, Date: time.Date(2026, time.February, 5, 22, 46, 40, 0, time.UTC), Description: "Attempt to connect to remote node frbpajcd02 failed"},
-				{Type: "P", ID: parser.RecordID{ID: "XIPT"}, Date: time.Date(2026, time.February, 5, 22, 47, 10, 0, time.UTC), Description: "SENDFILE", ProcessNumber: "21", Code: 8, MessageID: "XIPT004I"},
-				{Type: "E", ID: parser.RecordID{ID: "RNCF"}, Date: time.Date(2026, time.February, 5, 22, 47, 10, 0, time.UTC), Description: "Attempt to connect to remote node frbpajcd02 failed"},
+				{Type: "P", ID: parser.RemoteNodeConnectionError, Date: time.Date(2026, time.February, 5, 22, 45, 40, 0, time.UTC), Description: "SENDFILE", ProcessNumber: "21", Code: 8, MessageID: "XIPT004I"},
+				{Type: "E", ID: parser.RemoteNodeConnectionFailed, Date: time.Date(2026, time.February, 5, 22, 45, 40, 0, time.UTC), Description: "Attempt to connect to remote node frbpajcd02 failed. FRWL=N"},
+				{Type: "P", ID: parser.RemoteNodeConnectionError, Date: time.Date(2026, time.February, 5, 22, 46, 10, 0, time.UTC), Description: "SENDFILE", ProcessNumber: "21", Code: 8, MessageID: "XIPT004I"},
+				{Type: "E", ID: parser.RemoteNodeConnectionFailed, Date: time.Date(2026, time.February, 5, 22, 46, 10, 0, time.UTC), Description: "Attempt to connect to remote node frbpajcd02 failed. FRWL=N"},
+				{Type: "P", ID: parser.RemoteNodeConnectionError, Date: time.Date(2026, time.February, 5, 22, 46, 40, 0, time.UTC), Description: "SENDFILE", ProcessNumber: "21", Code: 8, MessageID: "XIPT004I"},
+				{Type: "E", ID: parser.RemoteNodeConnectionFailed, Date: time.Date(2026, time.February, 5, 22, 46, 40, 0, time.UTC), Description: "Attempt to connect to remote node frbpajcd02 failed. FRWL=N"},
+				{Type: "P", ID: parser.RemoteNodeConnectionError, Date: time.Date(2026, time.February, 5, 22, 47, 10, 0, time.UTC), Description: "SENDFILE", ProcessNumber: "21", Code: 8, MessageID: "XIPT004I"},
+				{Type: "E", ID: parser.RemoteNodeConnectionFailed, Date: time.Date(2026, time.February, 5, 22, 47, 10, 0, time.UTC), Description: "Attempt to connect to remote node frbpajcd02 failed. FRWL=N"},
 				{Type: "E", ID: parser.SubmitProcess, Date: time.Date(2026, time.February, 5, 22, 50, 40, 0, time.UTC), Description: "Submit command issued."},
-				{Type: "P", ID: parser.RecordID{ID: "XIPT"}, Date: time.Date(2026, time.February, 5, 22, 57, 10, 0, time.UTC), Description: "SENDFILE", ProcessNumber: "21", Code: 8, MessageID: "XIPT004I"},
-				{Type: "E", ID: parser.RecordID{ID: "RNCF"}, Date: time.Date(2026, time.February, 5, 22, 57, 10, 0, time.UTC), Description: "Attempt to connect to remote node frbpajcd02 failed"},
-				{Type: "P", ID: parser.RecordID{ID: "XIPT"}, Date: time.Date(2026, time.February, 5, 23, 7, 11, 0, time.UTC), Description: "SENDFILE", ProcessNumber: "21", Code: 8, MessageID: "XIPT004I"},
-				{Type: "E", ID: parser.RecordID{ID: "RNCF"}, Date: time.Date(2026, time.February, 5, 23, 7, 11, 0, time.UTC), Description: "Attempt to connect to remote node frbpajcd02 failed"},
-				{Type: "P", ID: parser.RecordID{ID: "XIPT"}, Date: time.Date(2026, time.February, 5, 23, 17, 11, 0, time.UTC), Description: "SENDFILE", ProcessNumber: "21", Code: 8, MessageID: "XIPT004I"},
-				{Type: "E", ID: parser.RecordID{ID: "RNCF"}, Date: time.Date(2026, time.February, 5, 23, 17, 11, 0, time.UTC), Description: "Attempt to connect to remote node frbpajcd02 failed"},
+				{Type: "P", ID: parser.RemoteNodeConnectionError, Date: time.Date(2026, time.February, 5, 22, 57, 10, 0, time.UTC), Description: "SENDFILE", ProcessNumber: "21", Code: 8, MessageID: "XIPT004I"},
+				{Type: "E", ID: parser.RemoteNodeConnectionFailed, Date: time.Date(2026, time.February, 5, 22, 57, 10, 0, time.UTC), Description: "Attempt to connect to remote node frbpajcd02 failed. FRWL=N"},
+				{Type: "P", ID: parser.RemoteNodeConnectionError, Date: time.Date(2026, time.February, 5, 23, 7, 11, 0, time.UTC), Description: "SENDFILE", ProcessNumber: "21", Code: 8, MessageID: "XIPT004I"},
+				{Type: "E", ID: parser.RemoteNodeConnectionFailed, Date: time.Date(2026, time.February, 5, 23, 7, 11, 0, time.UTC), Description: "Attempt to connect to remote node frbpajcd02 failed. FRWL=N"},
+				{Type: "P", ID: parser.RemoteNodeConnectionError, Date: time.Date(2026, time.February, 5, 23, 17, 11, 0, time.UTC), Description: "SENDFILE", ProcessNumber: "21", Code: 8, MessageID: "XIPT004I"},
+				{Type: "E", ID: parser.RemoteNodeConnectionFailed, Date: time.Date(2026, time.February, 5, 23, 17, 11, 0, time.UTC), Description: "Attempt to connect to remote node frbpajcd02 failed. FRWL=N"},
+			},
+		},
+		{
+			// ccode_extra_synthetic.txt is written by hand from the X header's columns as we have no
+			// captured output with X records. Replace it when a real capture is available.
+			inputFilepath: filepath.Join("testdata", "ccode_extra_synthetic.txt"),
+			expected: []parser.SummaryStat{
+				{Type: "X", ID: parser.ExternalIntegratedFileAgent, Date: time.Date(2026, time.February, 6, 14, 2, 11, 0, time.UTC), Code: 0, MessageID: "XIFA000I", ApplicationDescription: "IFA", UserID: "cdadmin", NodeName: "cdnode"},
+				{Type: "X", ID: parser.ExternalIntegratedFileAgent, Date: time.Date(2026, time.February, 6, 14, 2, 41, 0, time.UTC), Code: 0, MessageID: "XIFA001I", ApplicationDescription: "IFA", UserID: "cdadmin", NodeName: "cdnode"},
+				{Type: "X", ID: parser.ExternalIntegratedFileAgent, Date: time.Date(2026, time.February, 6, 14, 5, 12, 0, time.UTC), Code: 8, MessageID: "XIFA021E", ApplicationDescription: "IFA", UserID: "cdadmin", NodeName: "cdnode"},
 			},
 		},
 	}
@@ -178,3 +190,135 @@ func TestParseCCode_ByCode(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCCode_Continuation(t *testing.T) {
+	input := `
+	-------------------------------------------------------------------------------
+	E RNCF  02/05/2026 22:45:40 Attempt to connect to remote node frbpajcd02 failed
+	                            . FRWL=N
+	E SUBP  02/05/2026 22:50:40 Submit command issued.
+	P PSTR  02/05/2026 22:50:40 sample            14                0      XSMG200I
+	===============================================================================
+	`
+	got, err := parser.ParseCCode(input)
+	require.NoError(t, err)
+	require.Len(t, got.Stats, 3)
+
+	require.Equal(t, "Attempt to connect to remote node frbpajcd02 failed. FRWL=N", got.Stats[0].Description)
+	require.Equal(t, "Submit command issued.", got.Stats[1].Description)
+	require.Equal(t, "sample", got.Stats[2].Description)
+
+	// Messages wrapped between words are joined with a space, and only E records are continued
+	input = `
+	-------------------------------------------------------------------------------
+	E SIGC  02/05/2026 22:45:40 Signal caught by the session manager,
+	                            process will be retried.
+	P PSTR  02/05/2026 22:50:40 sample            14                0      XSMG200I
+	                            restarted
+	===============================================================================
+	`
+	got, err = parser.ParseCCode(input)
+	require.NoError(t, err)
+	require.Len(t, got.Stats, 2)
+
+	require.Equal(t, "Signal caught by the session manager, process will be retried.", got.Stats[0].Description)
+	require.Equal(t, "sample", got.Stats[1].Description)
+}
+
+func TestParseCCode_Layout(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("testdata", "ccode_layout.txt"))
+	require.NoError(t, err)
+
+	got, err := parser.ParseCCode(string(bs))
+	require.NoError(t, err)
+	require.Len(t, got.Stats, 6)
+
+	type result struct {
+		ID            string
+		Description   string
+		ProcessNumber string
+		StepName      string
+		Code          int
+		FeedbackCode  int
+		MessageID     string
+	}
+	var results []result
+	for _, stat := range got.Stats[1:5] {
+		results = append(results, result{stat.ID.ID, stat.Description, stat.ProcessNumber, stat.StepName, stat.Code, stat.FeedbackCode, stat.MessageID})
+	}
+	require.Equal(t, []result{
+		{"PSTR", "ach send", "21004", "", 0, 0, "XSMG200I"},
+		{"XCPS", "ach send", "21004", "step 1", 8, 13, "XCPS002I"},
+		{"CTRC", "ach send", "21004", "step 1", 8, 13, "XCPS002I"},
+		{"PRED", "ach send", "21004", "", 8, 0, "XCPS002I"},
+	}, results)
+
+	extra := got.Stats[5]
+	require.Equal(t, parser.ExternalIntegratedFileAgent, extra.ID)
+	require.Equal(t, "IFA SCAN", extra.ApplicationDescription)
+	require.Equal(t, "", extra.UserID)
+	require.Equal(t, "cd node", extra.NodeName)
+	require.Equal(t, 0, extra.Code)
+	require.Equal(t, "XIFA000I", extra.MessageID)
+}
+
+func TestParseCCode_LayoutFallback(t *testing.T) {
+	// Lines which don't follow the header's columns are split on whitespace
+	input := `
+P RECID LOG TIME            PNAME        PNUMBER  STEPNAME   CCOD FDBK MSGID
+-------------------------------------------------------------------------------
+P CTRC  02/03/2026 23:28:52 sample 14 step01 8 2 XCPS002I
+P CTRC  02/03/2026 23:28:52 sample            14  step01        0      SCPA000I
+===============================================================================
+`
+	got, err := parser.ParseCCode(input)
+	require.NoError(t, err)
+	require.Len(t, got.Stats, 2)
+
+	for _, stat := range got.Stats {
+		require.Equal(t, "sample", stat.Description)
+		require.Equal(t, "14", stat.ProcessNumber)
+		require.Equal(t, "step01", stat.StepName)
+	}
+	require.Equal(t, 8, got.Stats[0].Code)
+	require.Equal(t, 2, got.Stats[0].FeedbackCode)
+	require.Equal(t, "SCPA000I", got.Stats[1].MessageID)
+}
+
+func TestParseCCode_NoHeader(t *testing.T) {
+	// Without column headers each shape of a one word PNAME can be split on whitespace
+	input := `
+-------------------------------------------------------------------------------
+P PSTR  02/03/2026 23:28:45 sample            14                0      XSMG200I
+P XCPS  02/03/2026 23:26:40 sample            14                8   13 XCPS002I
+P CTRC  02/03/2026 23:26:40 sample            14  step01        8      XCPS002I
+P CTRC  02/03/2026 23:26:40 sample            14  step01        8   13 XCPS002I
+`
+	got, err := parser.ParseCCode(input)
+	require.NoError(t, err)
+	require.Len(t, got.Stats, 4)
+	for _, stat := range got.Stats {
+		require.Equal(t, "sample", stat.Description)
+		require.Equal(t, "14", stat.ProcessNumber)
+	}
+	require.Equal(t, 13, got.Stats[1].FeedbackCode)
+	require.Empty(t, got.Stats[1].StepName)
+	require.Equal(t, "step01", got.Stats[2].StepName)
+	require.Zero(t, got.Stats[2].FeedbackCode)
+	require.Equal(t, "step01", got.Stats[3].StepName)
+	require.Equal(t, 13, got.Stats[3].FeedbackCode)
+
+	// A PNAME with spaces needs the header to find PNUMBER, so those rows are skipped
+	input = `
+-------------------------------------------------------------------------------
+P PSTR  02/03/2026 23:28:45 ach send          21004                0      XSMG200I
+P CTRC  02/03/2026 23:28:52 ach send          21004  step 1        8   13 XCPS002I
+P CTRC  02/03/2026 23:28:52 ach file send     21004  step 1 of 2   8   13 XCPS002I
+P PRED  02/03/2026 23:28:52 sample            14                0      XSMG252I
+`
+	got, err = parser.ParseCCode(input)
+	require.NoError(t, err)
+	require.Len(t, got.Stats, 1)
+	require.Equal(t, parser.ProcessEnded, got.Stats[0].ID)
+	require.Equal(t, "14", got.Stats[0].ProcessNumber)
+}
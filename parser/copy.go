@@ -0,0 +1,129 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// CopyTermination holds the results of a copy step from a detail CTRC record.
+//
+// Example from the end of a record:
+//
+//	Ckpt=>N  Lkfl=>N  Rstr=>N  Xlat=>N  Scmp=>N  Ecmp=>Y  Ecpr=>0.00 CRC=>N
+//	FASP=>N
+//	Zlvl=>1  Zwin=>13  Zmem=>4
+//	...
+//	     Source                     Destination
+//	 Ccode      =>8              Ccode        =>8
+//	 Msgid      =>XCPS002I       Msgid        =>XSMG622I
+//	 Bytes Read =>0              Bytes Written=>0
+//	 Recs  Read =>0              Recs  Written=>0
+//	 Bytes Sent =>0              Bytes Recvd  =>0
+//	 Rus   Sent =>0              Rus   Recvd  =>0
+//	 Ru    Size =>65536
+type CopyTermination struct {
+	SourceFile      string
+	DestinationFile string
+
+	// LocalNode is "P" or "S" depending on which node logged the record
+	LocalNode string
+
+	SourceCode           int
+	SourceMessageID      string
+	DestinationCode      int
+	DestinationMessageID string
+
+	BytesRead      int64
+	BytesWritten   int64
+	RecordsRead    int64
+	RecordsWritten int64
+	BytesSent      int64
+	BytesReceived  int64
+	RUsSent        int64
+	RUsReceived    int64
+	RUSize         int64
+
+	Checkpoint          bool    // Ckpt
+	Restart             bool    // Rstr
+	Translate           bool    // Xlat
+	StandardCompression bool    // Scmp
+	ExtendedCompression bool    // Ecmp
+	CompressionPercent  float64 // Ecpr
+	CRC                 bool
+	FASP                bool
+
+	// Zlib settings used for extended compression
+	ZlibLevel  int // Zlvl
+	ZlibWindow int // Zwin
+	ZlibMemory int // Zmem
+}
+
+func parseCopyTermination(fields map[string]string) (*CopyTermination, error) {
+	out := &CopyTermination{
+		SourceFile:           fields["Src File"],
+		DestinationFile:      fields["Dest File"],
+		LocalNode:            fields["Local node"],
+		SourceMessageID:      fields["Source Msgid"],
+		DestinationMessageID: fields["Destination Msgid"],
+
+		Checkpoint:          fields["Ckpt"] == "Y",
+		Restart:             fields["Rstr"] == "Y",
+		Translate:           fields["Xlat"] == "Y",
+		StandardCompression: fields["Scmp"] == "Y",
+		ExtendedCompression: fields["Ecmp"] == "Y",
+		CRC:                 fields["CRC"] == "Y",
+		FASP:                fields["FASP"] == "Y",
+	}
+
+	ints := []struct {
+		key  string
+		dest *int
+	}{
+		{"Source Ccode", &out.SourceCode},
+		{"Destination Ccode", &out.DestinationCode},
+		{"Zlvl", &out.ZlibLevel},
+		{"Zwin", &out.ZlibWindow},
+		{"Zmem", &out.ZlibMemory},
+	}
+	for _, i := range ints {
+		n, err := parseDetailCode(fields[i.key])
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %v", i.key, err)
+		}
+		*i.dest = n
+	}
+
+	counts := []struct {
+		key  string
+		dest *int64
+	}{
+		{"Source Bytes Read", &out.BytesRead},
+		{"Destination Bytes Written", &out.BytesWritten},
+		{"Source Recs Read", &out.RecordsRead},
+		{"Destination Recs Written", &out.RecordsWritten},
+		{"Source Bytes Sent", &out.BytesSent},
+		{"Destination Bytes Recvd", &out.BytesReceived},
+		{"Source Rus Sent", &out.RUsSent},
+		{"Destination Rus Recvd", &out.RUsReceived},
+		{"Source Ru Size", &out.RUSize},
+	}
+	for _, c := range counts {
+		if v := fields[c.key]; v != "" {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parsing %s: %v", c.key, err)
+			}
+			*c.dest = n
+		}
+	}
+
+	if v := fields["Ecpr"]; v != "" {
+		n, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing Ecpr: %v", err)
+		}
+		out.CompressionPercent = n
+	}
+
+	return out, nil
+}
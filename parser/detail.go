@@ -0,0 +1,432 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type DetailStats struct {
+	Stats []DetailStat
+}
+
+// DetailStat is one EVENT RECORD or PROCESS RECORD block from "select statistics ... detail" output.
+type DetailStat struct {
+	// Type is the record type
+	//
+	// P: PROCESS RECORD blocks
+	// E: EVENT RECORD blocks
+	Type string
+
+	ID            RecordID
+	Date          time.Time
+	ProcessName   string
+	ProcessNumber string
+	OSProcessID   string
+
+	SubmitterClass string
+	SubmitterID    string
+
+	StepName    string
+	StepStart   time.Time
+	StepStop    time.Time
+	StepElapsed time.Duration
+
+	FromNode     string
+	SNode        string
+	Code         int
+	FeedbackCode int
+	MessageID    string
+	MessageText  string
+	ShortText    string
+
+	// Copy is set for copy termination (CTRC) records
+	Copy *CopyTermination
+
+	// Secure is set for records which include Secure+ session details, such as SSTR and CTRC
+	Secure *SecureSession
+
+	// TCQ is set for TCQ change (QCxx) records with the queues read from the message text
+	TCQ *TCQChange
+
+	// Fields contains every "Key => Value" pair found in the block, keyed by the label
+	// with repeated whitespace collapsed (e.g. "Src File"). Values from the two-column
+	// Source/Destination table of copy records are prefixed with "Source " or "Destination ".
+	//
+	// Only the first occurrence of a label is kept.
+	Fields map[string]string
+}
+
+// ParseDetail parses the output from an IBM Connect:Direct "select statistics ... detail" command into structured DetailStats.
+//
+// Each record begins with a header line and is made up of "Key => Value" pairs, with blocks separated by a line of hyphens.
+//
+//	PROCESS RECORD   Record Id =>  PSTR
+//	Process Name       => sample         Stat Log Date  => 02/03/2026
+//	Process Number     => 13             Stat Log Time  => 23:26:37.871
+//
+// Values which wrap onto indented lines are joined back together. Record IDs are resolved with the Registry from opts, or DefaultRegistry.
+//
+// Statistics can be viewed in Connect:Direct with commands like:
+//
+//	sel stat pnumber=13 detail;
+//
+// If the input is malformed (e.g., invalid date or unparseable codes), an error is returned.
+func ParseDetail(input string, opts ...Options) (DetailStats, error) {
+	registry := registryFrom(opts)
+
+	var out DetailStats
+
+	var block []string
+	flush := func() error {
+		if len(block) == 0 {
+			return nil
+		}
+		rec, err := parseDetailRecord(block, registry)
+		block = nil
+		if err != nil {
+			return err
+		}
+		if rec != nil {
+			out.Stats = append(out.Stats, *rec)
+		}
+		return nil
+	}
+
+	lines := strings.Split(input, "\n")
+	for _, line := range lines {
+		line = strings.TrimRight(line, " \t\r")
+		trimmed := strings.TrimSpace(line)
+
+		// Records are separated by hyphens and the output ends with a line of equals
+		if strings.Contains(trimmed, "------") || strings.Contains(trimmed, "======") {
+			if err := flush(); err != nil {
+				return out, err
+			}
+			continue
+		}
+
+		if isDetailHeader(trimmed) {
+			if err := flush(); err != nil {
+				return out, err
+			}
+			block = append(block, line)
+			continue
+		}
+
+		// Skip lines when we aren't inside of a record
+		if len(block) > 0 {
+			block = append(block, line)
+		}
+	}
+	if err := flush(); err != nil {
+		return out, err
+	}
+
+	return out, nil
+}
+
+// isDetailHeader returns true for lines like "EVENT RECORD     Record Id => QCEX"
+func isDetailHeader(line string) bool {
+	idx := strings.Index(line, "=>")
+	if idx < 0 {
+		return false
+	}
+	key := strings.Fields(line[:idx])
+	return len(key) == 4 && key[1] == "RECORD" && key[2] == "Record" && key[3] == "Id"
+}
+
+type detailPair struct {
+	key   string
+	value string
+}
+
+func parseDetailRecord(lines []string, registry *Registry) (*DetailStat, error) {
+	pairs := collectDetailPairs(lines)
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+
+	rec := &DetailStat{
+		Fields: make(map[string]string),
+	}
+
+	// The header pair looks like "PROCESS RECORD Record Id" => "PSTR"
+	header := strings.Fields(pairs[0].key)
+	switch header[0] {
+	case "EVENT":
+		rec.Type = "E"
+	case "PROCESS":
+		rec.Type = "P"
+	default:
+		rec.Type = header[0][:1]
+	}
+
+	ccode := registry.Lookup(pairs[0].value)
+	if ccode == nil {
+		ccode = &RecordID{
+			ID: strings.ToUpper(pairs[0].value),
+		}
+	}
+	rec.ID = *ccode
+
+	var logDate, logTime string
+	var stepStartDate, stepStartTime string
+	var stepStopDate, stepStopTime string
+
+	for _, pair := range pairs[1:] {
+		if _, exists := rec.Fields[pair.key]; exists {
+			continue
+		}
+		rec.Fields[pair.key] = pair.value
+
+		var err error
+		switch strings.ToLower(pair.key) {
+		case "process name":
+			rec.ProcessName = pair.value
+		case "process number":
+			rec.ProcessNumber = pair.value
+		case "stat log date":
+			logDate = pair.value
+		case "stat log time":
+			logTime = pair.value
+		case "os process id":
+			rec.OSProcessID = pair.value
+		case "submitter class":
+			rec.SubmitterClass = pair.value
+		case "submitter id":
+			rec.SubmitterID = pair.value
+		case "step name":
+			rec.StepName = pair.value
+		case "step start date":
+			stepStartDate = pair.value
+		case "step start time":
+			stepStartTime = pair.value
+		case "step stop date":
+			stepStopDate = pair.value
+		case "step stop time":
+			stepStopTime = pair.value
+		case "step elapsed time":
+			rec.StepElapsed, err = parseDetailElapsed(pair.value)
+			if err != nil {
+				return rec, fmt.Errorf("parsing %s elapsed time: %v", rec.ID.ID, err)
+			}
+		case "from node":
+			rec.FromNode = pair.value
+		case "snode":
+			rec.SNode = pair.value
+		case "completion code":
+			rec.Code, err = parseDetailCode(pair.value)
+			if err != nil {
+				return rec, fmt.Errorf("parsing %s completion code: %v", rec.ID.ID, err)
+			}
+		case "feedback code":
+			rec.FeedbackCode, err = parseDetailCode(pair.value)
+			if err != nil {
+				return rec, fmt.Errorf("parsing %s feedback code: %v", rec.ID.ID, err)
+			}
+		case "message id":
+			rec.MessageID = pair.value
+		case "message text":
+			rec.MessageText = pair.value
+		case "short text":
+			rec.ShortText = pair.value
+		}
+	}
+
+	rec.Secure = parseSecureSession(rec.Fields)
+	if strings.HasPrefix(rec.ID.ID, "QC") {
+		rec.TCQ = parseTCQChange(rec.MessageText)
+	}
+
+	var err error
+	if rec.ID.ID == CopyTerminationRecord.ID {
+		rec.Copy, err = parseCopyTermination(rec.Fields)
+		if err != nil {
+			return rec, fmt.Errorf("parsing %s copy: %v", rec.ID.ID, err)
+		}
+	}
+
+	rec.Date, err = parseDetailDate(logDate, logTime)
+	if err != nil {
+		return rec, fmt.Errorf("parsing %s date: %v", rec.ID.ID, err)
+	}
+	rec.StepStart, err = parseDetailDate(stepStartDate, stepStartTime)
+	if err != nil {
+		return rec, fmt.Errorf("parsing %s step start: %v", rec.ID.ID, err)
+	}
+	rec.StepStop, err = parseDetailDate(stepStopDate, stepStopTime)
+	if err != nil {
+		return rec, fmt.Errorf("parsing %s step stop: %v", rec.ID.ID, err)
+	}
+
+	return rec, nil
+}
+
+// collectDetailPairs reads every "Key => Value" pair from a record's lines in order.
+//
+// Values are wrapped at a fixed width by Connect:Direct, so indented lines without a key are
+// appended directly onto the previous value. Only the indentation up to the value's column is
+// removed from those lines.
+//
+//	Short Text       => Process started, process:13 name:sample SNODE:cdnod
+//	                    e
+func collectDetailPairs(lines []string) []detailPair {
+	var out []detailPair
+
+	var last *detailPair
+	var lastKeyCol, lastValueCol int
+	var inCopyTable bool
+
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			last = nil
+			continue
+		}
+
+		idxs := findAll(line, "=>")
+		if len(idxs) == 0 {
+			indent := len(line) - len(strings.TrimLeft(line, " "))
+
+			// Copy records include a two-column table of source and destination values
+			//      Source                     Destination
+			cols := strings.Fields(line)
+			if len(cols) == 2 && cols[0] == "Source" && cols[1] == "Destination" {
+				inCopyTable = true
+				last = nil
+				continue
+			}
+
+			if last != nil && indent >= lastKeyCol {
+				last.value += line[min(indent, lastValueCol):]
+			}
+			continue
+		}
+
+		pairs := splitDetailPairs(line, idxs)
+		if inCopyTable {
+			for i := range pairs {
+				if i == 0 {
+					pairs[i].key = "Source " + pairs[i].key
+				} else {
+					pairs[i].key = "Destination " + pairs[i].key
+				}
+			}
+		}
+		out = append(out, pairs...)
+
+		last = &out[len(out)-1]
+		lastKeyCol = idxs[len(idxs)-1]
+		lastValueCol = lastKeyCol + len("=> ")
+	}
+
+	for i := range out {
+		out[i].value = strings.TrimSpace(out[i].value)
+	}
+	return out
+}
+
+// splitDetailPairs splits a line which can contain multiple pairs, such as
+//
+//	Process Name     => sample         Stat Log Date    => 02/03/2026
+//	Ckpt=>N  Lkfl=>N  Rstr=>N  Xlat=>N  Scmp=>N  Ecmp=>Y  Ecpr=>0.00 CRC=>N
+//
+// The text between two separators holds the previous value and the next key. Values begin directly
+// after the separator, so a run of spaces there means the value is blank. Otherwise they're divided
+// at the first run of multiple spaces (or the last space when only one is present).
+//
+//	Step Start Date  =>                Step Start Time  =>
+//	 Recs  Read =>0              Recs  Written=>0
+func splitDetailPairs(line string, idxs []int) []detailPair {
+	out := make([]detailPair, len(idxs))
+	out[0].key = line[:idxs[0]]
+
+	for i := range idxs {
+		start := idxs[i] + len("=>")
+		if i == len(idxs)-1 {
+			out[i].value = line[start:]
+			break
+		}
+
+		between := strings.TrimRight(line[start:idxs[i+1]], " ")
+		if strings.HasPrefix(between, "  ") {
+			out[i+1].key = between
+			continue
+		}
+
+		between = strings.TrimLeft(between, " ")
+		split := strings.Index(between, "  ")
+		if split < 0 {
+			split = strings.LastIndex(between, " ")
+		}
+		if split < 0 {
+			out[i+1].key = between
+			continue
+		}
+		out[i].value = between[:split]
+		out[i+1].key = between[split:]
+	}
+
+	for i := range out {
+		out[i].key = strings.Join(strings.Fields(out[i].key), " ")
+		out[i].value = strings.TrimSpace(out[i].value)
+	}
+	return out
+}
+
+func findAll(line, sep string) []int {
+	var out []int
+	offset := 0
+	for {
+		idx := strings.Index(line[offset:], sep)
+		if idx < 0 {
+			return out
+		}
+		out = append(out, offset+idx)
+		offset += idx + len(sep)
+	}
+}
+
+func parseDetailCode(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	cc, err := strconv.ParseInt(value, 10, 16)
+	if err != nil {
+		return 0, err
+	}
+	return int(cc), nil
+}
+
+// parseDetailDate combines separate date and time values, such as "02/03/2026" and "23:26:37.579".
+// Blank values return a zero time.
+func parseDetailDate(date, clock string) (time.Time, error) {
+	if date == "" && clock == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse("01/02/2006 15:04:05", strings.TrimSpace(date+" "+clock))
+}
+
+// parseDetailElapsed reads durations formatted as "00:00:03"
+func parseDetailElapsed(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("unexpected format %q", value)
+	}
+
+	var out time.Duration
+	units := []time.Duration{time.Hour, time.Minute, time.Second}
+	for i, p := range parts {
+		n, err := strconv.ParseFloat(p, 64)
+		if err != nil {
+			return 0, err
+		}
+		out += time.Duration(n * float64(units[i]))
+	}
+	return out, nil
+}
@@ -0,0 +1,190 @@
+package parser_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/moov-io/go-connect-direct/parser"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDetail(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("testdata", "pnumber13_stats.txt"))
+	require.NoError(t, err)
+
+	got, err := parser.ParseDetail(string(bs))
+	require.NoError(t, err)
+	require.Len(t, got.Stats, 15)
+
+	var ids []string
+	for _, stat := range got.Stats {
+		ids = append(ids, stat.ID.ID)
+		require.Equal(t, "13", stat.ProcessNumber)
+	}
+	expected := []string{"QCEX", "SUBP", "SSTR", "PSTR", "PSTR", "XCPK", "FIOX", "XCPS", "LSST", "RSST", "CTRC", "CTRC", "PRED", "PRED", "SEND"}
+	require.Equal(t, expected, ids)
+
+	t.Run("event", func(t *testing.T) {
+		stat := got.Stats[1]
+		require.Equal(t, "E", stat.Type)
+		require.Equal(t, parser.SubmitProcess, stat.ID)
+		require.Equal(t, "", stat.ProcessName)
+		require.Equal(t, time.Date(2026, time.February, 3, 23, 26, 37, 580*int(time.Millisecond), time.UTC), stat.Date)
+		require.Equal(t, "2246106", stat.OSProcessID)
+		require.Equal(t, "cdadmin@cdnode", stat.SubmitterID)
+		require.Equal(t, time.Date(2026, time.February, 3, 23, 26, 37, 0, time.UTC), stat.StepStart)
+		require.True(t, stat.StepStop.IsZero())
+		require.Equal(t, "S", stat.FromNode)
+		require.Equal(t, "", stat.SNode)
+		require.Equal(t, "LCCC013I", stat.MessageID)
+		require.Equal(t, "Submit command issued.", stat.MessageText)
+		require.Equal(t, "Submit process command is complete", stat.ShortText)
+	})
+
+	t.Run("process", func(t *testing.T) {
+		stat := got.Stats[3]
+		require.Equal(t, "P", stat.Type)
+		require.Equal(t, parser.ProcessStarted, stat.ID)
+		require.Equal(t, "sample", stat.ProcessName)
+		require.Equal(t, "1", stat.SubmitterClass)
+		require.Equal(t, "55d43d73-85fc-4494-9f67-a100ec1a845b", stat.Fields["Submitter Instance"])
+		require.Equal(t, "cdnode", stat.SNode)
+		require.Equal(t, "XSMG200I", stat.MessageID)
+		require.Equal(t, "Process started, process:13 name:sample SNODE:cdnode", stat.ShortText)
+	})
+
+	t.Run("wrapped text", func(t *testing.T) {
+		stat := got.Stats[6]
+		require.Equal(t, 8, stat.Code)
+		require.Equal(t, "FIOX043E", stat.MessageID)
+		require.Contains(t, stat.ShortText, "error=Error on container/bucket 'moov-platform-staging-achgateway-fedach'")
+		require.Contains(t, stat.ShortText, "'outbound/1770161197.txt'  ibm-cd-fedach1@moov-platform-staging.iam.gserviceaccount.com")
+
+		stat = got.Stats[14]
+		require.Equal(t, parser.SessionEnded, stat.ID)
+		require.Equal(t, "Session ended, Session Manager shutting down SNODE:cdnode", stat.MessageText)
+	})
+
+	t.Run("feedback code", func(t *testing.T) {
+		stat := got.Stats[7]
+		require.Equal(t, 8, stat.Code)
+		require.Equal(t, 2, stat.FeedbackCode)
+		require.Equal(t, "Source file open failed. Filename=gs://moov-platform-staging-achgateway-fedach/outbound/1770161197.txt.", stat.ShortText)
+	})
+
+	t.Run("step", func(t *testing.T) {
+		stat := got.Stats[11]
+		require.Equal(t, parser.CopyTerminationRecord, stat.ID)
+		require.Equal(t, "step01", stat.StepName)
+		require.Equal(t, time.Date(2026, time.February, 3, 23, 26, 37, 916*int(time.Millisecond), time.UTC), stat.StepStart)
+		require.Equal(t, time.Date(2026, time.February, 3, 23, 26, 40, 814*int(time.Millisecond), time.UTC), stat.StepStop)
+		require.Equal(t, 3*time.Second, stat.StepElapsed)
+		require.Equal(t, "P", stat.FromNode)
+		require.Equal(t, "gs://moov-platform-staging-achgateway-fedach/outbound/1770161197.txt", stat.Fields["Src File"])
+		require.Equal(t, "Y", stat.Fields["Ecmp"])
+		require.Equal(t, "0.00", stat.Fields["Ecpr"])
+		require.Equal(t, "N", stat.Fields["CRC"])
+		require.Equal(t, "XCPS002I", stat.Fields["Source Msgid"])
+		require.Equal(t, "XSMG622I", stat.Fields["Destination Msgid"])
+	})
+}
+
+func TestParseDetail_Invalid(t *testing.T) {
+	input := `
+EVENT RECORD     Record Id => SUBP
+Process Name     =>                Stat Log Date    => 02/33/2026
+Process Number   => 13             Stat Log Time    => 23:26:37.580
+-------------------------------------------------------------------------------
+`
+	_, err := parser.ParseDetail(input)
+	require.ErrorContains(t, err, "parsing SUBP date")
+
+	input = `
+PROCESS RECORD   Record Id =>  PRED
+Completion Code  => eight
+`
+	_, err = parser.ParseDetail(input)
+	require.ErrorContains(t, err, "parsing PRED completion code")
+}
+
+func TestParseDetail_CopyTermination(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("testdata", "pnumber13_stats.txt"))
+	require.NoError(t, err)
+
+	got, err := parser.ParseDetail(string(bs))
+	require.NoError(t, err)
+
+	var copies []*parser.CopyTermination
+	for _, stat := range got.Stats {
+		if stat.ID == parser.CopyTerminationRecord {
+			require.NotNil(t, stat.Copy)
+			copies = append(copies, stat.Copy)
+		} else {
+			require.Nil(t, stat.Copy)
+		}
+	}
+	require.Len(t, copies, 2)
+
+	expected := &parser.CopyTermination{
+		SourceFile:           "gs://moov-platform-staging-achgateway-fedach/outbound/1770161197.txt",
+		DestinationFile:      "gs://moov-ibmcd-fedach-7b2egd/outbound/1770161197.txt",
+		LocalNode:            "P",
+		SourceCode:           8,
+		SourceMessageID:      "XCPS002I",
+		DestinationCode:      8,
+		DestinationMessageID: "XSMG622I",
+		RUSize:               65536,
+		ExtendedCompression:  true,
+		ZlibLevel:            1,
+		ZlibWindow:           13,
+		ZlibMemory:           4,
+	}
+	require.Equal(t, expected, copies[1])
+
+	require.Equal(t, "S", copies[0].LocalNode)
+	require.Zero(t, copies[0].ZlibLevel)
+}
+
+func TestParseDetail_SecureSession(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("testdata", "pnumber13_stats.txt"))
+	require.NoError(t, err)
+
+	got, err := parser.ParseDetail(string(bs))
+	require.NoError(t, err)
+
+	subject := parser.CertificateName{
+		CommonName:   "fedach-dit",
+		SerialNumber: "36:21:b4:3e:4b:af:33:7d:b0:35:01:85:af:57:60:39:41:60:a6:d3",
+		Fingerprint:  "c1463ad1cd9213786f8f655694608b5d17decffd",
+		Raw:          "(CN=fedach-dit,SN=36:21:b4:3e:4b:af:33:7d:b0:35:01:85:af:57:60:39:41:60:a6:d3,FP=c1463ad1cd9213786f8f655694608b5d17decffd)",
+	}
+	issuer := parser.CertificateName{
+		CommonName: "fedach-dit",
+		Raw:        "(CN=fedach-dit)",
+	}
+
+	var sessions int
+	for _, stat := range got.Stats {
+		switch stat.ID {
+		case parser.SessionStarted, parser.CopyTerminationRecord:
+			require.NotNil(t, stat.Secure, stat.ID.ID)
+			sessions++
+
+			require.Equal(t, parser.ProtocolTLS13, stat.Secure.Protocol)
+			require.Equal(t, "TLS_AES_256_GCM_SHA384", stat.Secure.CipherSuite)
+			require.Equal(t, "FIPS 140-2", stat.Secure.SecurityMode)
+			require.False(t, stat.Secure.ProtocolOverride)
+			require.False(t, stat.Secure.CipherSuiteOverride)
+			require.False(t, stat.Secure.EncryptDataOverride)
+			require.Equal(t, subject, stat.Secure.Subject)
+			require.Equal(t, issuer, stat.Secure.Issuer)
+
+		default:
+			require.Nil(t, stat.Secure, stat.ID.ID)
+		}
+	}
+	require.Equal(t, 3, sessions)
+}
@@ -0,0 +1,77 @@
+package parser
+
+// FeedbackCode explains the feedback code (FDBK) written with a completion code.
+//
+// Connect:Direct for UNIX reports the operating system error number (errno) as the feedback code when
+// a file or system call fails, such as a copy step with completion code 8 and feedback code 2 when the
+// source file doesn't exist. Error numbers differ between platforms, and the codes listed here are
+// the Linux, AIX and Solaris numbers. Some are numbered differently on macOS and the BSDs, where
+// EAGAIN is 35 rather than 11.
+type FeedbackCode struct {
+	Code int
+
+	// Name is the errno name, such as ENOENT
+	Name    string
+	Meaning string
+}
+
+// LookupFeedbackCode returns the FeedbackCode for a code, or nil when it isn't in the catalog.
+func LookupFeedbackCode(code int) *FeedbackCode {
+	fc, found := feedbackCodes[code]
+	if !found {
+		return nil
+	}
+	fc.Code = code
+	return &fc
+}
+
+// Feedback explains the record's FeedbackCode, or returns nil when it's 0 or unknown.
+func (s SummaryStat) Feedback() *FeedbackCode {
+	if s.FeedbackCode == 0 {
+		return nil
+	}
+	return LookupFeedbackCode(s.FeedbackCode)
+}
+
+// Feedback explains the record's FeedbackCode, or returns nil when it's 0 or unknown.
+func (s DetailStat) Feedback() *FeedbackCode {
+	if s.FeedbackCode == 0 {
+		return nil
+	}
+	return LookupFeedbackCode(s.FeedbackCode)
+}
+
+var feedbackCodes = map[int]FeedbackCode{
+	0:  {Meaning: "No additional information"},
+	1:  {Name: "EPERM", Meaning: "Operation not permitted"},
+	2:  {Name: "ENOENT", Meaning: "No such file or directory"},
+	3:  {Name: "ESRCH", Meaning: "No such process"},
+	4:  {Name: "EINTR", Meaning: "Interrupted system call"},
+	5:  {Name: "EIO", Meaning: "I/O error"},
+	6:  {Name: "ENXIO", Meaning: "No such device or address"},
+	7:  {Name: "E2BIG", Meaning: "Argument list too long"},
+	8:  {Name: "ENOEXEC", Meaning: "Exec format error"},
+	9:  {Name: "EBADF", Meaning: "Bad file number"},
+	10: {Name: "ECHILD", Meaning: "No child processes"},
+	11: {Name: "EAGAIN", Meaning: "Resource temporarily unavailable"},
+	12: {Name: "ENOMEM", Meaning: "Out of memory"},
+	13: {Name: "EACCES", Meaning: "Permission denied"},
+	14: {Name: "EFAULT", Meaning: "Bad address"},
+	16: {Name: "EBUSY", Meaning: "Device or resource busy"},
+	17: {Name: "EEXIST", Meaning: "File exists"},
+	18: {Name: "EXDEV", Meaning: "Cross-device link"},
+	19: {Name: "ENODEV", Meaning: "No such device"},
+	20: {Name: "ENOTDIR", Meaning: "Not a directory"},
+	21: {Name: "EISDIR", Meaning: "Is a directory"},
+	22: {Name: "EINVAL", Meaning: "Invalid argument"},
+	23: {Name: "ENFILE", Meaning: "File table overflow"},
+	24: {Name: "EMFILE", Meaning: "Too many open files"},
+	25: {Name: "ENOTTY", Meaning: "Not a typewriter"},
+	26: {Name: "ETXTBSY", Meaning: "Text file busy"},
+	27: {Name: "EFBIG", Meaning: "File too large"},
+	28: {Name: "ENOSPC", Meaning: "No space left on device"},
+	29: {Name: "ESPIPE", Meaning: "Illegal seek"},
+	30: {Name: "EROFS", Meaning: "Read-only file system"},
+	31: {Name: "EMLINK", Meaning: "Too many links"},
+	32: {Name: "EPIPE", Meaning: "Broken pipe"},
+}
@@ -0,0 +1,81 @@
+package parser_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/go-connect-direct/parser"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCCode_Feedback(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("testdata", "ccode_feedback.txt"))
+	require.NoError(t, err)
+
+	stats, err := parser.ParseCCode(string(bs))
+	require.NoError(t, err)
+	require.Len(t, stats.Stats, 7)
+
+	type result struct {
+		ID            string
+		ProcessNumber string
+		Code          int
+		FeedbackCode  int
+		MessageID     string
+	}
+	var got []result
+	for _, stat := range stats.Stats[1:] {
+		require.Equal(t, "sample", stat.Description)
+		got = append(got, result{stat.ID.ID, stat.ProcessNumber, stat.Code, stat.FeedbackCode, stat.MessageID})
+	}
+	require.Equal(t, []result{
+		{"PSTR", "13", 0, 0, "XSMG200I"},
+		{"XCPK", "13", 4, 0, "XCPK005W"},
+		{"FIOX", "13", 8, 0, "FIOX043E"},
+		{"XCPS", "13", 8, 2, "XCPS002I"},
+		{"CTRC", "13", 8, 2, "XCPS002I"},
+		{"PRED", "13", 8, 0, "XCPS002I"},
+	}, got)
+
+	copyStat := stats.Stats[5]
+	require.Equal(t, parser.CopyTerminationRecord, copyStat.ID)
+	fc := copyStat.Feedback()
+	require.NotNil(t, fc)
+	require.Equal(t, parser.FeedbackCode{Code: 2, Name: "ENOENT", Meaning: "No such file or directory"}, *fc)
+
+	require.Nil(t, stats.Stats[6].Feedback())
+}
+
+func TestLookupFeedbackCode(t *testing.T) {
+	fc := parser.LookupFeedbackCode(13)
+	require.NotNil(t, fc)
+	require.Equal(t, 13, fc.Code)
+	require.Equal(t, "EACCES", fc.Name)
+	require.Equal(t, "Permission denied", fc.Meaning)
+
+	fc = parser.LookupFeedbackCode(0)
+	require.NotNil(t, fc)
+	require.Equal(t, "No additional information", fc.Meaning)
+
+	require.Nil(t, parser.LookupFeedbackCode(9999))
+	require.Nil(t, parser.SummaryStat{FeedbackCode: 9999}.Feedback())
+}
+
+func TestDetailStat_Feedback(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("testdata", "pnumber13_stats.txt"))
+	require.NoError(t, err)
+
+	stats, err := parser.ParseDetail(string(bs))
+	require.NoError(t, err)
+
+	for _, stat := range stats.Stats {
+		if stat.ID.ID != "XCPS" {
+			require.Nil(t, stat.Feedback(), stat.ID.ID)
+			continue
+		}
+		require.Equal(t, 8, stat.Code)
+		require.Equal(t, "ENOENT", stat.Feedback().Name)
+	}
+}
@@ -0,0 +1,104 @@
+//go:build ignore
+
+// gen_records writes records_gen.go from the record IDs listed in records.csv
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"go/format"
+	"os"
+	"strings"
+	"text/template"
+)
+
+var categories = map[string]string{
+	"Event":           "CategoryEvent",
+	"Process":         "CategoryProcess",
+	"External Source": "CategoryExternalSource",
+}
+
+type record struct {
+	Name        string
+	ID          string
+	Category    string
+	Description string
+}
+
+var output = template.Must(template.New("records").Parse(`// Code generated by gen_records.go from records.csv; DO NOT EDIT.
+
+package parser
+
+var (
+{{- range . }}
+	{{ .Name }} = RecordID{
+		ID:          {{ printf "%q" .ID }},
+		Category:    {{ .Category }},
+		Description: {{ printf "%q" .Description }},
+	}
+{{ end -}}
+)
+
+// recordIDs are added to the default Registry
+var recordIDs = []RecordID{
+{{- range . }}
+	{{ .Name }},
+{{- end }}
+}
+`))
+
+func main() {
+	if err := run("records.csv", "records_gen.go"); err != nil {
+		fmt.Fprintf(os.Stderr, "gen_records: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(input, dest string) error {
+	f, err := os.Open(input)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", input, err)
+	}
+	if len(rows) == 0 {
+		return fmt.Errorf("%s is empty", input)
+	}
+
+	var records []record
+	names, ids := make(map[string]bool), make(map[string]bool)
+	for i, row := range rows[1:] {
+		if len(row) != 4 {
+			return fmt.Errorf("%s line %d: expected 4 columns, found %d", input, i+2, len(row))
+		}
+		rec := record{
+			Name:        strings.TrimSpace(row[0]),
+			ID:          strings.TrimSpace(row[1]),
+			Category:    categories[strings.TrimSpace(row[2])],
+			Description: strings.TrimSpace(row[3]),
+		}
+		if rec.Category == "" {
+			return fmt.Errorf("%s line %d: unknown category %q", input, i+2, row[2])
+		}
+		if names[rec.Name] || ids[rec.ID] {
+			return fmt.Errorf("%s line %d: duplicate record %s (%s)", input, i+2, rec.Name, rec.ID)
+		}
+		names[rec.Name], ids[rec.ID] = true, true
+		records = append(records, rec)
+	}
+
+	var buf bytes.Buffer
+	if err := output.Execute(&buf, records); err != nil {
+		return err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting %s: %w", dest, err)
+	}
+	return os.WriteFile(dest, src, 0644)
+}
@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"strings"
+)
+
+// summaryLayout is where each column starts in a header line of summary output, such as
+//
+//	P RECID LOG TIME            PNAME        PNUMBER  STEPNAME   CCOD FDBK MSGID
+//
+// Values are sliced from a record between the start of their column and the start of the next one,
+// which keeps blank columns (like STEPNAME or FDBK) and names containing spaces in their place.
+type summaryLayout struct {
+	names  []string
+	starts []int
+}
+
+// summaryColumns are the columns after LOG TIME for each record type with fixed-width output
+var summaryColumns = map[string][]string{
+	"P": {"PNAME", "PNUMBER", "STEPNAME", "CCOD", "FDBK", "MSGID"},
+	"X": {"APP DESC", "USID", "NODENAME", "CCOD", "MSGID"},
+}
+
+// parseSummaryLayout reads a header line, returning the record type it describes.
+// A nil layout is returned for other lines.
+func parseSummaryLayout(line string) (string, *summaryLayout) {
+	text := strings.TrimLeft(line, " \t")
+	cols := strings.Fields(text)
+	if len(cols) < 2 || cols[1] != "RECID" {
+		return "", nil
+	}
+	names, found := summaryColumns[cols[0]]
+	if !found {
+		return "", nil
+	}
+
+	layout := &summaryLayout{
+		names: names,
+	}
+	pos := 0
+	for _, name := range names {
+		idx := strings.Index(text[pos:], name)
+		if idx < 0 {
+			return "", nil
+		}
+		layout.starts = append(layout.starts, pos+idx)
+		pos += idx + len(name)
+	}
+	return cols[0], layout
+}
+
+// fields slices each column's value from a record line. False is returned when a value crosses
+// the start of a column, which means the line doesn't follow the layout.
+func (l *summaryLayout) fields(line string) (map[string]string, bool) {
+	text := strings.TrimLeft(line, " \t")
+
+	for _, start := range l.starts {
+		if start > 0 && start < len(text) && !isBlank(text[start-1]) && !isBlank(text[start]) {
+			return nil, false
+		}
+	}
+
+	out := make(map[string]string, len(l.names))
+	for i, name := range l.names {
+		start, end := l.starts[i], len(text)
+		if i+1 < len(l.starts) {
+			end = min(l.starts[i+1], len(text))
+		}
+		if start < end {
+			out[name] = strings.TrimSpace(text[start:end])
+		}
+	}
+	return out, true
+}
+
+func isBlank(c byte) bool {
+	return c == ' ' || c == '\t'
+}
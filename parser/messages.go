@@ -0,0 +1,397 @@
+package parser
+
+import (
+	"strings"
+)
+
+// Message IDs
+// https://www.ibm.com/docs/en/connect-direct/6.3.0?topic=messages-connectdirect-unix
+
+// MessageID describes a message Connect:Direct writes to statistics, such as XSMG200I.
+//
+// The first four characters of a message ID name the component which wrote it and the last is the severity.
+type MessageID struct {
+	ID        string
+	Severity  Severity
+	ShortText string
+
+	// Explanation is why the message was written
+	Explanation string
+
+	// SystemAction is what Connect:Direct did and Response is what an operator should do
+	SystemAction string
+	Response     string
+}
+
+// Severity is read from the last character of a message ID.
+type Severity string
+
+var (
+	SeverityInformational Severity = "Informational"
+	SeverityWarning       Severity = "Warning"
+	SeverityError         Severity = "Error"
+)
+
+// MessageSeverity returns the severity of a message ID from its trailing I, W or E.
+// An empty Severity is returned for IDs without one of those letters.
+func MessageSeverity(id string) Severity {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return ""
+	}
+	switch strings.ToUpper(id[len(id)-1:]) {
+	case "I":
+		return SeverityInformational
+	case "W":
+		return SeverityWarning
+	case "E":
+		return SeverityError
+	}
+	return ""
+}
+
+// LookupMessageID will lookup a message ID (like XCPS002I) and return the MessageID for it,
+// or nil when it isn't in the catalog.
+func LookupMessageID(id string) *MessageID {
+	msg, found := messageIDs[strings.ToUpper(strings.TrimSpace(id))]
+	if !found {
+		return nil
+	}
+	msg.Severity = MessageSeverity(msg.ID)
+	return &msg
+}
+
+// Message returns the catalog entry for the record's MessageID, or nil when it's unknown.
+func (s SummaryStat) Message() *MessageID {
+	return LookupMessageID(s.MessageID)
+}
+
+// Severity returns the severity of the record's MessageID, which is known even when the ID isn't in the catalog.
+func (s SummaryStat) Severity() Severity {
+	return MessageSeverity(s.MessageID)
+}
+
+func messageCatalog(msgs ...MessageID) map[string]MessageID {
+	out := make(map[string]MessageID, len(msgs))
+	for _, msg := range msgs {
+		out[msg.ID] = msg
+	}
+	return out
+}
+
+var messageIDs = messageCatalog(
+	// Command line and API
+	MessageID{
+		ID:           "LCCC013I",
+		ShortText:    "Submit process command is complete.",
+		Explanation:  "The process was accepted and placed on the TCQ.",
+		SystemAction: "The process is scheduled to run.",
+		Response:     "None.",
+	},
+	MessageID{
+		ID:           "XAPI005I",
+		ShortText:    "Unable to connect to the Connect:Direct server.",
+		Explanation:  "The CLI or API could not open a connection to cdpmgr, or the server rejected it.",
+		SystemAction: "The command is not run.",
+		Response:     "Verify the server is running and accepting API connections, and review the API trace for the return and feedback codes.",
+	},
+
+	// Command manager
+	MessageID{
+		ID:           "XCMM035I",
+		ShortText:    "Configuration file request failed.",
+		Explanation:  "A client request to view or update a configuration file, such as initparm.cfg, could not be completed.",
+		SystemAction: "The request fails.",
+		Response:     "Verify the file exists and is readable by the Connect:Direct user.",
+	},
+	MessageID{
+		ID:           "XCMM038I",
+		ShortText:    "Client authentication failed.",
+		Explanation:  "The user ID and password presented by a client could not be validated.",
+		SystemAction: "The client connection is closed.",
+		Response:     "Verify the credentials. XIDC001I is written on the validating side with the reason.",
+	},
+	MessageID{
+		ID:           "XCMM042I",
+		ShortText:    "Client signed on successfully.",
+		Explanation:  "A client connected to the command manager and signed on.",
+		SystemAction: "The session continues.",
+		Response:     "None.",
+	},
+	MessageID{
+		ID:           "XCMM076I",
+		ShortText:    "Configuration update failed.",
+		Explanation:  "A configuration update requested by a client could not be applied.",
+		SystemAction: "The configuration is unchanged.",
+		Response:     "Review the accompanying XNMP013E or XCFG001E message for the cause.",
+	},
+	MessageID{
+		ID:           "XCFG001E",
+		ShortText:    "Configuration file update error.",
+		Explanation:  "A client update to a configuration file could not be written.",
+		SystemAction: "The configuration file is unchanged.",
+		Response:     "Correct the reported problem and retry the update.",
+	},
+	MessageID{
+		ID:           "XNMP013E",
+		ShortText:    "Netmap update error.",
+		Explanation:  "A client update to netmap.cfg could not be applied.",
+		SystemAction: "The netmap is unchanged.",
+		Response:     "Correct the reported record or parameter and retry the update.",
+	},
+	MessageID{
+		ID:           "XIDC001I",
+		ShortText:    "Credential validation failed.",
+		Explanation:  "The reason credentials were rejected, such as an expired password. Only administrators can view this message.",
+		SystemAction: "The connection is rejected.",
+		Response:     "Correct the account or password on the validating node.",
+	},
+	MessageID{
+		ID:           "XUPC050I",
+		ShortText:    "Invalid USID value received from a client.",
+		Explanation:  "The user ID sent by a client was invalid, such as being too long.",
+		SystemAction: "The client connection is rejected.",
+		Response:     "Use a shorter user ID or certificate common name.",
+	},
+	MessageID{
+		ID:           "XRIA001I",
+		ShortText:    "License information.",
+		Explanation:  "Statistics about the license were recorded.",
+		SystemAction: "Processing continues.",
+		Response:     "None.",
+	},
+	MessageID{
+		ID:           "XRIA002I",
+		ShortText:    "Remote node information request failed.",
+		Explanation:  "Information about a remote node couldn't be returned to a client, such as for a node with an unsupported ostype.",
+		SystemAction: "The request fails.",
+		Response:     "Review the netmap entry for the remote node.",
+	},
+
+	// Copy
+	MessageID{
+		ID:           "SCPA000I",
+		ShortText:    "Copy step successful.",
+		Explanation:  "The copy step completed and the file was transferred.",
+		SystemAction: "The process continues with the next step.",
+		Response:     "None.",
+	},
+	MessageID{
+		ID:           "XCPS002I",
+		ShortText:    "Source file open failed.",
+		Explanation:  "The source file of a copy step couldn't be opened, such as when it doesn't exist, permissions deny access or a symbolic wasn't resolved.",
+		SystemAction: "The copy step fails with a completion code of 8.",
+		Response:     "Verify the file name and that the submitting user can read it.",
+	},
+	MessageID{
+		ID:           "XCPK005W",
+		ShortText:    "Checkpointing disabled for the copy step.",
+		Explanation:  "Checkpoint restart couldn't be used, such as when the checkpoint is outside the range supported by an object store.",
+		SystemAction: "The copy continues without checkpointing.",
+		Response:     "None, unless restarting large transfers is required.",
+	},
+	MessageID{
+		ID:           "XCPZ001I",
+		ShortText:    "Translation table open failed.",
+		Explanation:  "The translation (xlate) table for a copy step could not be opened.",
+		SystemAction: "The copy step fails.",
+		Response:     "Verify the table exists in ndm/xlate or the path given by the process.",
+	},
+	MessageID{
+		ID:           "XSQF009I",
+		ShortText:    "File open failed.",
+		Explanation:  "A file used by the copy step could not be opened.",
+		SystemAction: "The copy step fails.",
+		Response:     "Verify the file name and permissions.",
+	},
+	MessageID{
+		ID:           "SCZF004E",
+		ShortText:    "Could not open zFBA devices.",
+		Explanation:  "A copy step using z/OS FlashCopy based access couldn't open the devices.",
+		SystemAction: "The copy step fails.",
+		Response:     "Verify the zFBA device configuration.",
+	},
+
+	// File I/O exits, such as object stores
+	MessageID{
+		ID:           "FIOX020E",
+		ShortText:    "Object store credentials failed.",
+		Explanation:  "The credentials for the object store, such as an S3 profile or assumed role, couldn't be used.",
+		SystemAction: "The copy step fails.",
+		Response:     "Verify the object store credentials and profile configuration.",
+	},
+	MessageID{
+		ID:           "FIOX021E",
+		ShortText:    "IOExitFileWriter.write failed.",
+		Explanation:  "Writing to the object store failed.",
+		SystemAction: "The copy step fails.",
+		Response:     "Review the error reported by the object store and retry the process.",
+	},
+	MessageID{
+		ID:           "FIOX023E",
+		ShortText:    "Checkpoint resynchronization failed.",
+		Explanation:  "A restarted copy to an object store couldn't resume from its checkpoint.",
+		SystemAction: "The copy step restarts from the beginning.",
+		Response:     "None.",
+	},
+	MessageID{
+		ID:           "FIOX043E",
+		ShortText:    "IOExitFactory.createReader failed.",
+		Explanation:  "The object store named by the source file couldn't be opened for reading, such as when the bucket or object doesn't exist or access is denied.",
+		SystemAction: "The copy step fails.",
+		Response:     "Verify the object URL, such as gs:// or s3://, and the object store credentials.",
+	},
+	MessageID{
+		ID:           "FIOX044E",
+		ShortText:    "IOExitFactory.createWriter failed.",
+		Explanation:  "The object store named by the destination file couldn't be opened for writing.",
+		SystemAction: "The copy step fails.",
+		Response:     "Verify the object URL, the sysopts and the object store credentials.",
+	},
+
+	// Session and process management
+	MessageID{
+		ID:           "XSMG200I",
+		ShortText:    "Process started.",
+		Explanation:  "The session manager started running the process.",
+		SystemAction: "The process runs.",
+		Response:     "None.",
+	},
+	MessageID{
+		ID:           "XSMG201I",
+		ShortText:    "Step started.",
+		Explanation:  "A step of the process started.",
+		SystemAction: "The step runs.",
+		Response:     "None.",
+	},
+	MessageID{
+		ID:           "XSMG242I",
+		ShortText:    "Security authentication failed.",
+		Explanation:  "The remote submitter couldn't be authenticated for the session.",
+		SystemAction: "The session fails.",
+		Response:     "Verify the remote user proxy in userfile.cfg and the snodeid of the process.",
+	},
+	MessageID{
+		ID:           "XSMG245I",
+		ShortText:    "User authentication failed.",
+		Explanation:  "The user ID and password for a server connection couldn't be validated.",
+		SystemAction: "The session fails.",
+		Response:     "Verify the credentials. XIDC001I is written with the reason.",
+	},
+	MessageID{
+		ID:           "XSMG252I",
+		ShortText:    "Process ended.",
+		Explanation:  "The process finished. The completion code gives the highest return code of its steps.",
+		SystemAction: "The process is removed from the TCQ.",
+		Response:     "None, unless the completion code is not 0.",
+	},
+	MessageID{
+		ID:           "XSMG424I",
+		ShortText:    "Run task failed.",
+		Explanation:  "The run task step couldn't report its result, such as when the RPC call to log statistics timed out.",
+		SystemAction: "The step fails.",
+		Response:     "Review the program run by the step and the statistics for its result.",
+	},
+	MessageID{
+		ID:           "XSMG622I",
+		ShortText:    "Step failed on the other node.",
+		Explanation:  "The remote node reported why the step failed, with its own message ID.",
+		SystemAction: "The step fails.",
+		Response:     "Review the message from the other node.",
+	},
+	MessageID{
+		ID:           "XPAE003I",
+		ShortText:    "Process syntax error.",
+		Explanation:  "The process text couldn't be parsed.",
+		SystemAction: "The process is not submitted.",
+		Response:     "Correct the process and submit it again.",
+	},
+	MessageID{
+		ID:           "XSTL011E",
+		ShortText:    "Statistics exit program failed.",
+		Explanation:  "The stats.exit.program in initparm.cfg couldn't be run, such as when it doesn't exist or isn't executable.",
+		SystemAction: "Statistics are logged without the exit.",
+		Response:     "Correct stats.exit.program in initparm.cfg.",
+	},
+
+	// Process manager and network
+	MessageID{
+		ID:           "XIPT004I",
+		ShortText:    "Connect to remote node failed.",
+		Explanation:  "A TCP/IP connection to the remote node couldn't be established, such as when it's down, unreachable or refusing connections.",
+		SystemAction: "The process is retried according to the netmap retry settings, then placed in the hold queue.",
+		Response:     "Verify the remote node's address and port in netmap.cfg and that it's running.",
+	},
+	MessageID{
+		ID:           "XIPT007I",
+		ShortText:    "Connection closed during negotiation.",
+		Explanation:  "An incoming connection was closed before a session was established, such as by a load balancer probe.",
+		SystemAction: "The connection is closed.",
+		Response:     "None, unless sessions are expected from the address.",
+	},
+	MessageID{
+		ID:           "XIPT016I",
+		ShortText:    "Remote node connection failed.",
+		Explanation:  "A connection from a remote node couldn't be accepted.",
+		SystemAction: "The connection is closed.",
+		Response:     "Verify cdpmgr is responding and review the statistics for XPMD messages.",
+	},
+	MessageID{
+		ID:           "XIPT022I",
+		ShortText:    "Connection from an address which isn't trusted.",
+		Explanation:  "A connection was received while port.check trusted.addr was set.",
+		SystemAction: "The connection is checked against the trusted addresses.",
+		Response:     "Review trusted.addr in the port.check record of initparm.cfg.",
+	},
+	MessageID{
+		ID:           "XPMC002I",
+		ShortText:    "Connection closed by the client.",
+		Explanation:  "A client closed its connection to the process manager.",
+		SystemAction: "The connection is closed.",
+		Response:     "None.",
+	},
+	MessageID{
+		ID:           "XPMD002I",
+		ShortText:    "Fork() for cmgr/smgr child failed.",
+		Explanation:  "The process manager couldn't start a command or session manager, such as when the system is out of memory or processes.",
+		SystemAction: "The connection is rejected.",
+		Response:     "Review system resources and limits for the Connect:Direct user.",
+	},
+	MessageID{
+		ID:           "XPMD005I",
+		ShortText:    "Connection handoff failed.",
+		Explanation:  "An incoming connection couldn't be passed to a command or session manager.",
+		SystemAction: "The connection is closed.",
+		Response:     "Review system resources and restart the server if the process manager is unresponsive.",
+	},
+	MessageID{
+		ID:           "XPMD009I",
+		ShortText:    "Manager execution failed.",
+		Explanation:  "ndmcmgr or ndmsmgr couldn't be run to handle a connection, such as when a required system library is missing.",
+		SystemAction: "The connection is closed.",
+		Response:     "Correct the reported problem with the installation.",
+	},
+	MessageID{
+		ID:           "XUTL001I",
+		ShortText:    "Memory allocation failed.",
+		Explanation:  "malloc failed, such as when the system is out of memory.",
+		SystemAction: "The operation fails.",
+		Response:     "Review memory use and system limits.",
+	},
+
+	// Secure+
+	MessageID{
+		ID:           "CSPA091E",
+		ShortText:    "Secure+ protocol negotiation failed.",
+		Explanation:  "The nodes couldn't agree on a Secure+ protocol.",
+		SystemAction: "The session fails.",
+		Response:     "Verify the protocols enabled for the remote node in Secure+.",
+	},
+	MessageID{
+		ID:           "CSPA204E",
+		ShortText:    "Secure read failed.",
+		Explanation:  "gsk_secure_soc_read() returned an error, such as GSK_ERROR_IO when the connection is broken.",
+		SystemAction: "The session fails.",
+		Response:     "Review the network connection between the nodes.",
+	},
+)
@@ -0,0 +1,69 @@
+package parser_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/go-connect-direct/parser"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupMessageID(t *testing.T) {
+	msg := parser.LookupMessageID("xcps002i")
+	require.NotNil(t, msg)
+	require.Equal(t, "XCPS002I", msg.ID)
+	require.Equal(t, parser.SeverityInformational, msg.Severity)
+	require.Equal(t, "Source file open failed.", msg.ShortText)
+	require.NotEmpty(t, msg.Explanation)
+	require.NotEmpty(t, msg.SystemAction)
+	require.NotEmpty(t, msg.Response)
+
+	msg = parser.LookupMessageID("XCPK005W")
+	require.NotNil(t, msg)
+	require.Equal(t, parser.SeverityWarning, msg.Severity)
+
+	msg = parser.LookupMessageID("FIOX043E")
+	require.NotNil(t, msg)
+	require.Equal(t, parser.SeverityError, msg.Severity)
+
+	require.Nil(t, parser.LookupMessageID("ABCD999I"))
+	require.Nil(t, parser.LookupMessageID(""))
+}
+
+func TestMessageSeverity(t *testing.T) {
+	require.Equal(t, parser.SeverityInformational, parser.MessageSeverity("XSMG200I"))
+	require.Equal(t, parser.SeverityWarning, parser.MessageSeverity("xcpk005w"))
+	require.Equal(t, parser.SeverityError, parser.MessageSeverity("ABCD999E"))
+	require.Equal(t, parser.Severity(""), parser.MessageSeverity("XSMG200"))
+	require.Equal(t, parser.Severity(""), parser.MessageSeverity(""))
+}
+
+func TestSummaryStat_Message(t *testing.T) {
+	for _, name := range []string{"ccode_stats.txt", "ccode_error.txt"} {
+		bs, err := os.ReadFile(filepath.Join("testdata", name))
+		require.NoError(t, err)
+
+		stats, err := parser.ParseCCode(string(bs))
+		require.NoError(t, err)
+
+		for _, stat := range stats.Stats {
+			if stat.MessageID == "" {
+				require.Nil(t, stat.Message())
+				continue
+			}
+			msg := stat.Message()
+			require.NotNil(t, msg, stat.MessageID)
+			require.Equal(t, stat.Severity(), msg.Severity)
+		}
+	}
+
+	stat := parser.SummaryStat{MessageID: "XIPT004I"}
+	require.Equal(t, "Connect to remote node failed.", stat.Message().ShortText)
+	require.Equal(t, parser.SeverityInformational, stat.Severity())
+
+	stat = parser.SummaryStat{MessageID: "XIFA021E"}
+	require.Nil(t, stat.Message())
+	require.Equal(t, parser.SeverityError, stat.Severity())
+}
@@ -0,0 +1,151 @@
+package parser
+
+import (
+	"time"
+)
+
+// Process summarizes the lifecycle of a single Connect:Direct process from its statistics records.
+type Process struct {
+	Name   string
+	Number string
+
+	Submitted time.Time // from SUBP
+	Started   time.Time // from PSTR
+	Ended     time.Time // from PRED
+
+	// Code and MessageID are the final completion code and message of the process,
+	// which are only set once the process has ended.
+	Code      int
+	MessageID string
+
+	// Copies contains the copy termination (CTRC) records of each step.
+	// Both the PNODE and SNODE can log a record for the same step.
+	Copies []SummaryStat
+
+	// Warnings contains process records which completed with CompletionCodeWarning, such as XCPK.
+	Warnings []SummaryStat
+
+	// Stats contains every record for the process in the order they were logged.
+	Stats []SummaryStat
+}
+
+// HasStarted returns true when a process started (PSTR) record was found.
+func (p Process) HasStarted() bool {
+	return !p.Started.IsZero()
+}
+
+// HasEnded returns true when a process ended (PRED) record was found.
+func (p Process) HasEnded() bool {
+	return !p.Ended.IsZero()
+}
+
+// Succeeded returns true when the process ended with CompletionCodeSuccess.
+func (p Process) Succeeded() bool {
+	return p.HasEnded() && p.Code == CompletionCodeSuccess
+}
+
+// Duration returns the time between when the process was submitted (or started) and when it ended.
+// Zero is returned for processes which have not ended.
+func (p Process) Duration() time.Duration {
+	if !p.HasEnded() {
+		return 0
+	}
+
+	start := p.Started
+	if !p.Submitted.IsZero() && (start.IsZero() || p.Submitted.Before(start)) {
+		start = p.Submitted
+	}
+	if start.IsZero() {
+		return 0
+	}
+	return p.Ended.Sub(start)
+}
+
+// Processes groups the records by process number and name in the order each process first appears.
+// Process numbers are reused, so records with the same number but another name are a different process.
+//
+// Submit (SUBP) records do not include a process number in summary output, so each one is
+// assigned to the process of the next record which has a process number. Records without a
+// process name are assigned to the last process with their number. Other records without a
+// process number are not included.
+func (ss SummaryStats) Processes() []Process {
+	type key struct {
+		number, name string
+	}
+
+	var out []Process
+	index := make(map[key]int)
+	latest := make(map[string]int)
+
+	var submits []SummaryStat
+	for _, stat := range ss.Stats {
+		if stat.ProcessNumber == "" {
+			if stat.ID.ID == SubmitProcess.ID {
+				submits = append(submits, stat)
+			}
+			continue
+		}
+
+		idx, found := latest[stat.ProcessNumber]
+		if stat.Type == "P" {
+			k := key{number: stat.ProcessNumber, name: stat.Description}
+			idx, found = index[k]
+			if !found {
+				index[k] = len(out)
+			}
+		}
+		if !found {
+			idx = len(out)
+			out = append(out, Process{
+				Number: stat.ProcessNumber,
+			})
+		}
+		latest[stat.ProcessNumber] = idx
+		proc := &out[idx]
+
+		for _, sub := range submits {
+			proc.add(sub)
+		}
+		submits = nil
+
+		proc.add(stat)
+	}
+
+	return out
+}
+
+func (p *Process) add(stat SummaryStat) {
+	p.Stats = append(p.Stats, stat)
+
+	if p.Name == "" && stat.Type == "P" {
+		p.Name = stat.Description
+	}
+
+	switch stat.ID.ID {
+	case SubmitProcess.ID:
+		if p.Submitted.IsZero() {
+			p.Submitted = stat.Date
+		}
+
+	case ProcessStarted.ID:
+		if p.Started.IsZero() || stat.Date.Before(p.Started) {
+			p.Started = stat.Date
+		}
+
+	case ProcessEnded.ID:
+		if !p.HasEnded() || stat.Code >= p.Code {
+			p.Code = stat.Code
+			p.MessageID = stat.MessageID
+		}
+		if stat.Date.After(p.Ended) {
+			p.Ended = stat.Date
+		}
+
+	case CopyTerminationRecord.ID:
+		p.Copies = append(p.Copies, stat)
+	}
+
+	if stat.Type == "P" && stat.Code == CompletionCodeWarning {
+		p.Warnings = append(p.Warnings, stat)
+	}
+}
@@ -0,0 +1,104 @@
+package parser_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moov-io/go-connect-direct/parser"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummaryStats_Processes(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("testdata", "ccode_stats.txt"))
+	require.NoError(t, err)
+
+	stats, err := parser.ParseCCode(string(bs))
+	require.NoError(t, err)
+
+	procs := stats.Processes()
+	require.Len(t, procs, 1)
+
+	proc := procs[0]
+	require.Equal(t, "sample", proc.Name)
+	require.Equal(t, "14", proc.Number)
+	require.Equal(t, time.Date(2026, time.February, 3, 23, 28, 45, 0, time.UTC), proc.Submitted)
+	require.Equal(t, time.Date(2026, time.February, 3, 23, 28, 45, 0, time.UTC), proc.Started)
+	require.Equal(t, time.Date(2026, time.February, 3, 23, 28, 52, 0, time.UTC), proc.Ended)
+	require.Equal(t, 7*time.Second, proc.Duration())
+
+	require.True(t, proc.HasStarted())
+	require.True(t, proc.HasEnded())
+	require.True(t, proc.Succeeded())
+	require.Equal(t, parser.CompletionCodeSuccess, proc.Code)
+	require.Equal(t, "XSMG252I", proc.MessageID)
+
+	require.Len(t, proc.Copies, 2)
+	require.Len(t, proc.Warnings, 1)
+	require.Equal(t, parser.CheckpointingDisabled, proc.Warnings[0].ID)
+	require.Len(t, proc.Stats, 8)
+}
+
+func TestSummaryStats_ProcessesIncomplete(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("testdata", "ccode_error.txt"))
+	require.NoError(t, err)
+
+	stats, err := parser.ParseCCode(string(bs))
+	require.NoError(t, err)
+
+	procs := stats.Processes()
+	require.Len(t, procs, 1)
+
+	proc := procs[0]
+	require.Equal(t, "SENDFILE", proc.Name)
+	require.Equal(t, "21", proc.Number)
+	require.Equal(t, time.Date(2026, time.February, 5, 22, 50, 40, 0, time.UTC), proc.Submitted)
+
+	require.False(t, proc.HasStarted())
+	require.False(t, proc.HasEnded())
+	require.False(t, proc.Succeeded())
+	require.Zero(t, proc.Duration())
+
+	require.Empty(t, proc.Copies)
+	require.Empty(t, proc.Warnings)
+	require.Len(t, proc.Stats, 8)
+}
+
+func TestSummaryStats_ProcessesReusedNumber(t *testing.T) {
+	input := strings.Join([]string{
+		"===============================================================================",
+		"                           SELECT  STATISTICS",
+		"===============================================================================",
+		"P RECID LOG TIME            PNAME        PNUMBER  STEPNAME   CCOD FDBK MSGID",
+		"E RECID LOG TIME            MESSAGE TEXT",
+		"X RECID LOG TIME            APP DESC     USID     NODENAME   CCOD MSGID",
+		"-------------------------------------------------------------------------------",
+		"P PSTR  02/03/2026 23:28:45 sample            14                0      XSMG200I",
+		"P PRED  02/03/2026 23:28:52 sample            14                8      XSMG252I",
+		"E SUBP  02/04/2026 08:00:01 Submit command issued.",
+		"P PSTR  02/04/2026 08:00:01 ACHSEND           14                0      XSMG200I",
+		"P PRED  02/04/2026 08:00:09 ACHSEND           14                0      XSMG252I",
+		"===============================================================================",
+		"Select Statistics Completed Successfully.",
+	}, "\n")
+
+	stats, err := parser.ParseCCode(input)
+	require.NoError(t, err)
+
+	procs := stats.Processes()
+	require.Len(t, procs, 2)
+
+	require.Equal(t, "sample", procs[0].Name)
+	require.Equal(t, "14", procs[0].Number)
+	require.Equal(t, 8, procs[0].Code)
+	require.Len(t, procs[0].Stats, 2)
+
+	require.Equal(t, "ACHSEND", procs[1].Name)
+	require.Equal(t, "14", procs[1].Number)
+	require.True(t, procs[1].Succeeded())
+	require.Equal(t, time.Date(2026, time.February, 4, 8, 0, 1, 0, time.UTC), procs[1].Submitted)
+	require.Len(t, procs[1].Stats, 3)
+}
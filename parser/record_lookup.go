@@ -1,91 +1,184 @@
 package parser
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
 	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
 )
 
 // Record IDs
 // https://www.ibm.com/docs/en/connect-direct/6.3.0?topic=processes-submitting-process
 
 // LookupRecordID will lookup a code (like CTRC) and return the RecordID for it (Copy Termination Record)
+// from the default Registry.
 func LookupRecordID(code string) *RecordID {
-	cc := strings.ToUpper(code)
+	return defaultRegistry.Lookup(code)
+}
+
+// Registry holds the record IDs used when parsing statistics. It is safe for concurrent use.
+//
+// Record IDs added by new fixes, or written by site-specific programs, can be registered without
+// waiting for them to be added to the catalog.
+type Registry struct {
+	mu    sync.RWMutex
+	codes map[string]RecordID
+}
+
+var defaultRegistry = NewRegistry()
+
+// DefaultRegistry returns the Registry used by LookupRecordID and by parsers without a Registry in their Options.
+// Record IDs registered with it are seen by every parser.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// NewRegistry returns a Registry containing the catalog of known record IDs.
+func NewRegistry() *Registry {
+	r := &Registry{
+		codes: make(map[string]RecordID, len(recordIDs)),
+	}
+	for _, rid := range recordIDs {
+		r.codes[strings.ToUpper(rid.ID)] = rid
+	}
+	return r
+}
 
-	if strings.HasPrefix(cc, "QC") {
-		return &TcqChange
+// Register adds record IDs to the registry, replacing any with the same ID.
+// Each record ID needs an ID and a Category of Event, Process or External Source.
+func (r *Registry) Register(rids ...RecordID) error {
+	for _, rid := range rids {
+		if err := validateRecordID(rid); err != nil {
+			return err
+		}
 	}
 
-	rc, found := recordCodes[cc]
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, rid := range rids {
+		r.codes[strings.ToUpper(rid.ID)] = rid
+	}
+	return nil
+}
+
+func validateRecordID(rid RecordID) error {
+	if rid.ID == "" || strings.ContainsAny(rid.ID, " \t") {
+		return fmt.Errorf("invalid record ID %q", rid.ID)
+	}
+	switch rid.Category {
+	case CategoryEvent, CategoryProcess, CategoryExternalSource:
+		return nil
+	}
+	return fmt.Errorf("record ID %s: invalid category %q", rid.ID, rid.Category)
+}
+
+// Lookup returns the RecordID for a code (like CTRC), or nil when it isn't registered.
+func (r *Registry) Lookup(code string) *RecordID {
+	cc := strings.ToUpper(code)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rc, found := r.codes[cc]
 	if found {
 		return &rc
 	}
+	// TCQ changes to other queues keep their code, such as QCXX
+	if strings.HasPrefix(cc, "QC") && len(cc) == len(TcqChange.ID) {
+		if rc, found := r.codes[strings.ToUpper(TcqChange.ID)]; found {
+			rc.ID = cc
+			return &rc
+		}
+	}
 	return nil
 }
 
-var (
-	recordCodes = map[string]RecordID{
-		"CMOT":  ClientManagerCommTermination,
-		"CSPA":  SecurePlus,
-		"CSPE":  StrongPasswordEncryption,
-		"CTRC":  CopyTerminationRecord,
-		"EXFA":  ExternalIntegratedFileAgent,
-		"FMSD":  FmhSent,
-		"FMRV":  FmhReceived,
-		"IFED":  StepEndedForIf,
-		"RJED":  StepEndedForRunTask,
-		"RTED":  StepEndedForRunJob,
-		"SBED":  StepEndedForSubmit,
-		"PSED":  StepEndedForOther,
-		"LSMG":  SessionManager,
-		"LSST":  LocalStepStarted,
-		"RSST":  RemoteStepStarted,
-		"NUIC":  ServerStartupNuic,
-		"NUTR":  ServerShutdownNutr,
-		"NUT1":  ServerShutdownNut1,
-		"NUT2":  ServerShutdownNut2,
-		"NUTC":  ServerShutdownNutc,
-		"NUIS":  ServerShutdownNuis,
-		"PMIP":  ProcessManagerInitializing,
-		"PMST":  ProcessManagerStarted,
-		"PMED":  ProcessManagerEnded,
-		"PMMX":  TcqMaxAgeProcessing,
-		"PSTR":  ProcessStarted,
-		"PRED":  ProcessEnded,
-		"PERR":  ProcessError,
-		"PRIN":  ProcessInterrupted,
-		"PFLS":  ProcessFlushed,
-		"PSAV":  ProcessSaved,
-		"QCxx":  TcqChange,
-		"SCNT":  ConcurrentSessionCount,
-		"SLFA":  SelectFunctionalAuthorities,
-		"CHFA":  ChangeFunctionalAuthorities,
-		"DLFA":  DeleteFunctionalAuthorities,
-		"AUPR":  AuthorizationFileProcessing,
-		"SLIP":  SelectInitparms,
-		"IPPR":  UpdateInitparms,
-		"RFIP":  RefreshInitparms,
-		"SLNM":  SelectNetmap,
-		"CHNM":  ChangeNetmap,
-		"NMPR":  NetmapProcess,
-		"SLPX":  SelectProxy,
-		"CHPX":  ChangeProxy,
-		"DLPX":  DeleteProxy,
-		"SMIN":  SessionManagerInitialized,
-		"SMED":  SessionManagerEnded,
-		"SRSP":  SelectProcessResponse,
-		"STRS":  StatisticsResponse,
-		"SSTR":  SessionStarted,
-		"SEND":  SessionEnded,
-		"SERR":  SessionError,
-		"STOP":  ShutdownCommand,
-		"SUBP":  SubmitProcess,
-		"CHCG":  ChangeProcess,
-		"DELP":  DeleteProcess,
-		"TRON":  TraceOn,
-		"TROFF": TraceOff,
-		"USEC":  UserSecurity,
-
-		// warnings
-		"XCPK": CheckpointingDisabled,
+// All returns every registered record ID ordered by ID.
+func (r *Registry) All() []RecordID {
+	r.mu.RLock()
+	out := make([]RecordID, 0, len(r.codes))
+	for _, rid := range r.codes {
+		out = append(out, rid)
 	}
-)
+	r.mu.RUnlock()
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
+
+// recordIDFile is one record ID read by Load
+type recordIDFile struct {
+	ID          string `json:"id" yaml:"id"`
+	Category    string `json:"category" yaml:"category"`
+	Description string `json:"description" yaml:"description"`
+}
+
+// Load registers the record IDs read from JSON or YAML, which is a list of objects like:
+//
+//   - id: XABC
+//     category: Process
+//     description: Site transfer audit
+//
+// Categories are matched without regard to case. Nothing is registered when any record ID is invalid.
+func (r *Registry) Load(in io.Reader) error {
+	var records []recordIDFile
+	if err := yaml.NewDecoder(in).Decode(&records); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("loading record IDs: %w", err)
+	}
+
+	rids := make([]RecordID, 0, len(records))
+	for _, rec := range records {
+		rid := RecordID{
+			ID:          strings.TrimSpace(rec.ID),
+			Category:    RecordCategoy(strings.TrimSpace(rec.Category)),
+			Description: rec.Description,
+		}
+		for _, category := range []RecordCategoy{CategoryEvent, CategoryProcess, CategoryExternalSource} {
+			if strings.EqualFold(string(rid.Category), string(category)) {
+				rid.Category = category
+			}
+		}
+		rids = append(rids, rid)
+	}
+	if err := r.Register(rids...); err != nil {
+		return fmt.Errorf("loading record IDs: %w", err)
+	}
+	return nil
+}
+
+// LoadFile registers the record IDs from a JSON or YAML file.
+func (r *Registry) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("loading record IDs: %w", err)
+	}
+	defer f.Close()
+
+	if err := r.Load(f); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// Options change how statistics are parsed.
+type Options struct {
+	// Registry resolves record IDs. DefaultRegistry is used when it's nil.
+	Registry *Registry
+}
+
+func registryFrom(opts []Options) *Registry {
+	for _, opt := range opts {
+		if opt.Registry != nil {
+			return opt.Registry
+		}
+	}
+	return defaultRegistry
+}
@@ -0,0 +1,144 @@
+package parser_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/moov-io/go-connect-direct/parser"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	reg := parser.NewRegistry()
+
+	rid := reg.Lookup("ctrc")
+	require.NotNil(t, rid)
+	require.Equal(t, parser.CopyTerminationRecord, *rid)
+	require.Equal(t, parser.TcqChangeExecution, *reg.Lookup("QCEX"))
+	require.Nil(t, reg.Lookup("ZACH"))
+
+	all := reg.All()
+	require.Equal(t, len(parser.DefaultRegistry().All()), len(all))
+	for i := 1; i < len(all); i++ {
+		require.Less(t, all[i-1].ID, all[i].ID)
+	}
+
+	audit := parser.RecordID{ID: "ZACH", Category: parser.CategoryProcess, Description: "ACH file audit"}
+	require.NoError(t, reg.Register(audit))
+	require.Equal(t, audit, *reg.Lookup("zach"))
+	require.Len(t, reg.All(), len(all)+1)
+
+	// Other registries aren't changed
+	require.Nil(t, parser.LookupRecordID("ZACH"))
+	require.Nil(t, parser.NewRegistry().Lookup("ZACH"))
+}
+
+func TestRegistry_Invalid(t *testing.T) {
+	reg := parser.NewRegistry()
+
+	err := reg.Register(parser.RecordID{ID: "", Category: parser.CategoryEvent})
+	require.ErrorContains(t, err, `invalid record ID ""`)
+
+	err = reg.Register(
+		parser.RecordID{ID: "ZACH", Category: parser.CategoryProcess},
+		parser.RecordID{ID: "ZREJ", Category: "Other"},
+	)
+	require.ErrorContains(t, err, `record ID ZREJ: invalid category "Other"`)
+	require.Nil(t, reg.Lookup("ZACH"))
+
+	err = reg.Load(strings.NewReader(`[{"id": "ZACH"}]`))
+	require.ErrorContains(t, err, `loading record IDs: record ID ZACH: invalid category ""`)
+
+	err = reg.LoadFile(filepath.Join("testdata", "missing.yaml"))
+	require.ErrorContains(t, err, "loading record IDs: ")
+}
+
+func TestRegistry_Load(t *testing.T) {
+	reg := parser.NewRegistry()
+	require.NoError(t, reg.LoadFile(filepath.Join("testdata", "record_ids.yaml")))
+
+	rid := reg.Lookup("ZACH")
+	require.NotNil(t, rid)
+	require.Equal(t, parser.RecordID{ID: "ZACH", Category: parser.CategoryProcess, Description: "ACH file audit"}, *rid)
+	require.Equal(t, "Remote node connection failed (retrying)", reg.Lookup("RNCF").Description)
+
+	reg = parser.NewRegistry()
+	require.NoError(t, reg.LoadFile(filepath.Join("testdata", "record_ids.json")))
+	require.NotNil(t, reg.Lookup("ZACH"))
+	require.Equal(t, parser.CategoryExternalSource, reg.Lookup("ZREJ").Category)
+
+	// Empty input registers nothing
+	require.NoError(t, reg.Load(strings.NewReader("")))
+}
+
+func TestRegistry_Concurrent(t *testing.T) {
+	reg := parser.NewRegistry()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			id := fmt.Sprintf("Z%03d", i)
+			require.NoError(t, reg.Register(parser.RecordID{ID: id, Category: parser.CategoryEvent}))
+			require.NotNil(t, reg.Lookup(id))
+			require.NotNil(t, reg.Lookup("CTRC"))
+			require.NotEmpty(t, reg.All())
+		}(i)
+	}
+	wg.Wait()
+
+	require.Len(t, reg.All(), len(parser.NewRegistry().All())+10)
+}
+
+func TestRegistry_Options(t *testing.T) {
+	reg := parser.NewRegistry()
+	require.NoError(t, reg.LoadFile(filepath.Join("testdata", "record_ids.yaml")))
+	opts := parser.Options{Registry: reg}
+
+	bs, err := os.ReadFile(filepath.Join("testdata", "ccode_error.txt"))
+	require.NoError(t, err)
+
+	stats, err := parser.ParseCCode(string(bs), opts)
+	require.NoError(t, err)
+	require.Equal(t, "RNCF", stats.Stats[1].ID.ID)
+	require.Equal(t, "Remote node connection failed (retrying)", stats.Stats[1].ID.Description)
+
+	stats, err = parser.ParseCCode(string(bs))
+	require.NoError(t, err)
+	require.Equal(t, parser.RemoteNodeConnectionFailed, stats.Stats[1].ID)
+
+	input := strings.Join([]string{
+		"===============================================================================",
+		"P RECID LOG TIME            PNAME        PNUMBER  STEPNAME   CCOD FDBK MSGID",
+		"-------------------------------------------------------------------------------",
+		"P ZACH  02/03/2026 23:28:45 sample            14                0      XSMG200I",
+		"===============================================================================",
+	}, "\n")
+	stats, err = parser.ParseCCode(input, opts)
+	require.NoError(t, err)
+	require.Len(t, stats.Stats, 1)
+	require.True(t, stats.Stats[0].ID.Known())
+	require.Equal(t, "ACH file audit", stats.Stats[0].ID.Description)
+
+	bs, err = os.ReadFile(filepath.Join("testdata", "pnumber13_stats.txt"))
+	require.NoError(t, err)
+
+	reg = parser.NewRegistry()
+	require.NoError(t, reg.Register(parser.RecordID{ID: "SSTR", Category: parser.CategoryEvent, Description: "Session started (site)"}))
+
+	detail, err := parser.ParseDetail(string(bs), parser.Options{Registry: reg})
+	require.NoError(t, err)
+	require.Equal(t, "Session started (site)", detail.Stats[2].ID.Description)
+
+	transcript, err := parser.ParseTranscript("Direct> sel stat ccode(ge,0) pnumber=21;\n"+input+"\nSelect Statistics Completed Successfully.\n", opts)
+	require.NoError(t, err)
+	require.Len(t, transcript.Commands, 1)
+	require.Equal(t, "ACH file audit", transcript.Commands[0].Summary.Stats[0].ID.Description)
+}
@@ -0,0 +1,597 @@
+// Code generated by gen_records.go from records.csv; DO NOT EDIT.
+
+package parser
+
+var (
+	ClientManagerCommTermination = RecordID{
+		ID:          "CMOT",
+		Category:    CategoryEvent,
+		Description: "Client manager comm termination",
+	}
+
+	SecurePlus = RecordID{
+		ID:          "CSPA",
+		Category:    CategoryProcess,
+		Description: "Secure+",
+	}
+
+	StrongPasswordEncryption = RecordID{
+		ID:          "CSPE",
+		Category:    CategoryEvent,
+		Description: "Strong Password Encryption",
+	}
+
+	CopyTerminationRecord = RecordID{
+		ID:          "CTRC",
+		Category:    CategoryProcess,
+		Description: "Copy Termination Record",
+	}
+
+	ExternalIntegratedFileAgent = RecordID{
+		ID:          "EXFA",
+		Category:    CategoryExternalSource,
+		Description: "External Integrated File Agent",
+	}
+
+	FmhSent = RecordID{
+		ID:          "FMSD",
+		Category:    CategoryProcess,
+		Description: "FMH sent",
+	}
+
+	FmhReceived = RecordID{
+		ID:          "FMRV",
+		Category:    CategoryProcess,
+		Description: "FMH received",
+	}
+
+	StepEndedForIf = RecordID{
+		ID:          "IFED",
+		Category:    CategoryProcess,
+		Description: "Step ended for IF",
+	}
+
+	StepEndedForRunTask = RecordID{
+		ID:          "RJED",
+		Category:    CategoryProcess,
+		Description: "Step ended for RUN TASK",
+	}
+
+	StepEndedForRunJob = RecordID{
+		ID:          "RTED",
+		Category:    CategoryProcess,
+		Description: "Step ended for RUN JOB",
+	}
+
+	StepEndedForSubmit = RecordID{
+		ID:          "SBED",
+		Category:    CategoryProcess,
+		Description: "Step ended for SUBMIT",
+	}
+
+	StepEndedForOther = RecordID{
+		ID:          "PSED",
+		Category:    CategoryProcess,
+		Description: "Step ended for other",
+	}
+
+	SessionManager = RecordID{
+		ID:          "LSMG",
+		Category:    CategoryProcess,
+		Description: "Session Manager",
+	}
+
+	LocalStepStarted = RecordID{
+		ID:          "LSST",
+		Category:    CategoryProcess,
+		Description: "Local step started",
+	}
+
+	RemoteStepStarted = RecordID{
+		ID:          "RSST",
+		Category:    CategoryProcess,
+		Description: "Remote step started",
+	}
+
+	ServerStartupNuic = RecordID{
+		ID:          "NUIC",
+		Category:    CategoryEvent,
+		Description: "Server Startup",
+	}
+
+	ServerShutdownNutr = RecordID{
+		ID:          "NUTR",
+		Category:    CategoryEvent,
+		Description: "Server Shutdown",
+	}
+
+	ServerShutdownNut1 = RecordID{
+		ID:          "NUT1",
+		Category:    CategoryEvent,
+		Description: "Server Shutdown",
+	}
+
+	ServerShutdownNut2 = RecordID{
+		ID:          "NUT2",
+		Category:    CategoryEvent,
+		Description: "Server Shutdown",
+	}
+
+	ServerShutdownNutc = RecordID{
+		ID:          "NUTC",
+		Category:    CategoryEvent,
+		Description: "Server Shutdown",
+	}
+
+	ServerShutdownNuis = RecordID{
+		ID:          "NUIS",
+		Category:    CategoryEvent,
+		Description: "Server Shutdown",
+	}
+
+	ProcessManagerInitializing = RecordID{
+		ID:          "PMIP",
+		Category:    CategoryEvent,
+		Description: "Process manager initializing",
+	}
+
+	ProcessManagerStarted = RecordID{
+		ID:          "PMST",
+		Category:    CategoryEvent,
+		Description: "Process manager started",
+	}
+
+	ProcessManagerEnded = RecordID{
+		ID:          "PMED",
+		Category:    CategoryEvent,
+		Description: "Process manager ended",
+	}
+
+	TcqMaxAgeProcessing = RecordID{
+		ID:          "PMMX",
+		Category:    CategoryEvent,
+		Description: "TCQ max age processing",
+	}
+
+	ProcessStarted = RecordID{
+		ID:          "PSTR",
+		Category:    CategoryProcess,
+		Description: "Process started",
+	}
+
+	ProcessEnded = RecordID{
+		ID:          "PRED",
+		Category:    CategoryProcess,
+		Description: "Process ended",
+	}
+
+	ProcessError = RecordID{
+		ID:          "PERR",
+		Category:    CategoryProcess,
+		Description: "Process error",
+	}
+
+	ProcessInterrupted = RecordID{
+		ID:          "PRIN",
+		Category:    CategoryProcess,
+		Description: "Process interrupted",
+	}
+
+	ProcessFlushed = RecordID{
+		ID:          "PFLS",
+		Category:    CategoryProcess,
+		Description: "Process flushed",
+	}
+
+	ProcessSaved = RecordID{
+		ID:          "PSAV",
+		Category:    CategoryProcess,
+		Description: "Process saved",
+	}
+
+	TcqChange = RecordID{
+		ID:          "QCxx",
+		Category:    CategoryEvent,
+		Description: "TCQ change (xx typically identifies the new queue)",
+	}
+
+	TcqChangeExecution = RecordID{
+		ID:          "QCEX",
+		Category:    CategoryEvent,
+		Description: "TCQ change to the Execution queue",
+	}
+
+	TcqChangeWait = RecordID{
+		ID:          "QCWA",
+		Category:    CategoryEvent,
+		Description: "TCQ change to the Wait queue",
+	}
+
+	TcqChangeTimer = RecordID{
+		ID:          "QCTI",
+		Category:    CategoryEvent,
+		Description: "TCQ change to the Timer queue",
+	}
+
+	TcqChangeHold = RecordID{
+		ID:          "QCHO",
+		Category:    CategoryEvent,
+		Description: "TCQ change to the Hold queue",
+	}
+
+	ConcurrentSessionCount = RecordID{
+		ID:          "SCNT",
+		Category:    CategoryEvent,
+		Description: "Concurrent session count",
+	}
+
+	SelectFunctionalAuthorities = RecordID{
+		ID:          "SLFA",
+		Category:    CategoryEvent,
+		Description: "Select functional authorities",
+	}
+
+	ChangeFunctionalAuthorities = RecordID{
+		ID:          "CHFA",
+		Category:    CategoryEvent,
+		Description: "Change functional authorities",
+	}
+
+	DeleteFunctionalAuthorities = RecordID{
+		ID:          "DLFA",
+		Category:    CategoryEvent,
+		Description: "Delete functional authorities",
+	}
+
+	AuthorizationFileProcessing = RecordID{
+		ID:          "AUPR",
+		Category:    CategoryEvent,
+		Description: "Authorization file processing",
+	}
+
+	SelectInitparms = RecordID{
+		ID:          "SLIP",
+		Category:    CategoryEvent,
+		Description: "Select initparms",
+	}
+
+	UpdateInitparms = RecordID{
+		ID:          "IPPR",
+		Category:    CategoryEvent,
+		Description: "Update initparms",
+	}
+
+	RefreshInitparms = RecordID{
+		ID:          "RFIP",
+		Category:    CategoryEvent,
+		Description: "Refresh initparms",
+	}
+
+	SelectNetmap = RecordID{
+		ID:          "SLNM",
+		Category:    CategoryEvent,
+		Description: "Select netmap",
+	}
+
+	ChangeNetmap = RecordID{
+		ID:          "CHNM",
+		Category:    CategoryEvent,
+		Description: "Change netmap",
+	}
+
+	NetmapProcess = RecordID{
+		ID:          "NMPR",
+		Category:    CategoryEvent,
+		Description: "Netmap process",
+	}
+
+	SelectProxy = RecordID{
+		ID:          "SLPX",
+		Category:    CategoryEvent,
+		Description: "Select proxy",
+	}
+
+	ChangeProxy = RecordID{
+		ID:          "CHPX",
+		Category:    CategoryEvent,
+		Description: "Change proxy",
+	}
+
+	DeleteProxy = RecordID{
+		ID:          "DLPX",
+		Category:    CategoryEvent,
+		Description: "Delete proxy",
+	}
+
+	SessionManagerInitialized = RecordID{
+		ID:          "SMIN",
+		Category:    CategoryEvent,
+		Description: "Session manager initialized",
+	}
+
+	SessionManagerEnded = RecordID{
+		ID:          "SMED",
+		Category:    CategoryEvent,
+		Description: "Session manager ended",
+	}
+
+	SelectProcessResponse = RecordID{
+		ID:          "SRSP",
+		Category:    CategoryEvent,
+		Description: "Select process response",
+	}
+
+	StatisticsResponse = RecordID{
+		ID:          "STRS",
+		Category:    CategoryEvent,
+		Description: "Select statistics response",
+	}
+
+	SessionStarted = RecordID{
+		ID:          "SSTR",
+		Category:    CategoryEvent,
+		Description: "Session started",
+	}
+
+	SessionEnded = RecordID{
+		ID:          "SEND",
+		Category:    CategoryEvent,
+		Description: "Session ended",
+	}
+
+	SessionError = RecordID{
+		ID:          "SERR",
+		Category:    CategoryEvent,
+		Description: "Session error",
+	}
+
+	ShutdownCommand = RecordID{
+		ID:          "STOP",
+		Category:    CategoryEvent,
+		Description: "Shutdown command",
+	}
+
+	SubmitProcess = RecordID{
+		ID:          "SUBP",
+		Category:    CategoryEvent,
+		Description: "Submit process",
+	}
+
+	ChangeProcess = RecordID{
+		ID:          "CHCG",
+		Category:    CategoryEvent,
+		Description: "Change process",
+	}
+
+	DeleteProcess = RecordID{
+		ID:          "DELP",
+		Category:    CategoryEvent,
+		Description: "Delete process",
+	}
+
+	TraceOn = RecordID{
+		ID:          "TRON",
+		Category:    CategoryEvent,
+		Description: "Trace on",
+	}
+
+	TraceOff = RecordID{
+		ID:          "TROFF",
+		Category:    CategoryEvent,
+		Description: "Trace off",
+	}
+
+	UserSecurity = RecordID{
+		ID:          "USEC",
+		Category:    CategoryProcess,
+		Description: "User security",
+	}
+
+	CheckpointingDisabled = RecordID{
+		ID:          "XCPK",
+		Category:    CategoryProcess,
+		Description: "Checkpointing was disabled for the copy step",
+	}
+
+	ChangeProcessCommand = RecordID{
+		ID:          "CHGP",
+		Category:    CategoryEvent,
+		Description: "Change Process command issued",
+	}
+
+	FlushProcessCommand = RecordID{
+		ID:          "FLSP",
+		Category:    CategoryEvent,
+		Description: "Flush Process command issued",
+	}
+
+	SelectProcessCommand = RecordID{
+		ID:          "SELP",
+		Category:    CategoryEvent,
+		Description: "Select Process command issued",
+	}
+
+	SelectStatisticsCommand = RecordID{
+		ID:          "SELS",
+		Category:    CategoryEvent,
+		Description: "Select Statistics command issued",
+	}
+
+	TraceCommand = RecordID{
+		ID:          "TRAC",
+		Category:    CategoryEvent,
+		Description: "Trace command issued",
+	}
+
+	NodeAuthorizationCheck = RecordID{
+		ID:          "NAUH",
+		Category:    CategoryEvent,
+		Description: "Node authorization check",
+	}
+
+	RemoteNodeConnectionFailed = RecordID{
+		ID:          "RNCF",
+		Category:    CategoryEvent,
+		Description: "Remote node connection failed",
+	}
+
+	SignalCaught = RecordID{
+		ID:          "SIGC",
+		Category:    CategoryEvent,
+		Description: "Signal caught",
+	}
+
+	RemoteNodeConnectionError = RecordID{
+		ID:          "XIPT",
+		Category:    CategoryProcess,
+		Description: "Connection to the remote node failed",
+	}
+
+	CopySourceFileError = RecordID{
+		ID:          "XCPS",
+		Category:    CategoryProcess,
+		Description: "Copy source file error",
+	}
+
+	CopyDestinationFileError = RecordID{
+		ID:          "XCPR",
+		Category:    CategoryProcess,
+		Description: "Copy destination file error",
+	}
+
+	CopyTranslationError = RecordID{
+		ID:          "XCPZ",
+		Category:    CategoryProcess,
+		Description: "Copy translation table error",
+	}
+
+	SequentialFileError = RecordID{
+		ID:          "XSQF",
+		Category:    CategoryProcess,
+		Description: "Sequential file error",
+	}
+
+	FileIOExitError = RecordID{
+		ID:          "FIOX",
+		Category:    CategoryProcess,
+		Description: "File I/O exit error",
+	}
+
+	SessionManagerError = RecordID{
+		ID:          "XSMG",
+		Category:    CategoryProcess,
+		Description: "Session manager error",
+	}
+
+	ProcessSyntaxError = RecordID{
+		ID:          "XPAE",
+		Category:    CategoryProcess,
+		Description: "Process syntax error",
+	}
+
+	GetProcessCommand = RecordID{
+		ID:          "GPRC",
+		Category:    CategoryEvent,
+		Description: "Get Process command issued",
+	}
+
+	UnknownCommand = RecordID{
+		ID:          "CUKN",
+		Category:    CategoryEvent,
+		Description: "Unknown command issued",
+	}
+
+	ServerShutdown = RecordID{
+		ID:          "SHUD",
+		Category:    CategoryEvent,
+		Description: "Server Shutdown",
+	}
+)
+
+// recordIDs are added to the default Registry
+var recordIDs = []RecordID{
+	ClientManagerCommTermination,
+	SecurePlus,
+	StrongPasswordEncryption,
+	CopyTerminationRecord,
+	ExternalIntegratedFileAgent,
+	FmhSent,
+	FmhReceived,
+	StepEndedForIf,
+	StepEndedForRunTask,
+	StepEndedForRunJob,
+	StepEndedForSubmit,
+	StepEndedForOther,
+	SessionManager,
+	LocalStepStarted,
+	RemoteStepStarted,
+	ServerStartupNuic,
+	ServerShutdownNutr,
+	ServerShutdownNut1,
+	ServerShutdownNut2,
+	ServerShutdownNutc,
+	ServerShutdownNuis,
+	ProcessManagerInitializing,
+	ProcessManagerStarted,
+	ProcessManagerEnded,
+	TcqMaxAgeProcessing,
+	ProcessStarted,
+	ProcessEnded,
+	ProcessError,
+	ProcessInterrupted,
+	ProcessFlushed,
+	ProcessSaved,
+	TcqChange,
+	TcqChangeExecution,
+	TcqChangeWait,
+	TcqChangeTimer,
+	TcqChangeHold,
+	ConcurrentSessionCount,
+	SelectFunctionalAuthorities,
+	ChangeFunctionalAuthorities,
+	DeleteFunctionalAuthorities,
+	AuthorizationFileProcessing,
+	SelectInitparms,
+	UpdateInitparms,
+	RefreshInitparms,
+	SelectNetmap,
+	ChangeNetmap,
+	NetmapProcess,
+	SelectProxy,
+	ChangeProxy,
+	DeleteProxy,
+	SessionManagerInitialized,
+	SessionManagerEnded,
+	SelectProcessResponse,
+	StatisticsResponse,
+	SessionStarted,
+	SessionEnded,
+	SessionError,
+	ShutdownCommand,
+	SubmitProcess,
+	ChangeProcess,
+	DeleteProcess,
+	TraceOn,
+	TraceOff,
+	UserSecurity,
+	CheckpointingDisabled,
+	ChangeProcessCommand,
+	FlushProcessCommand,
+	SelectProcessCommand,
+	SelectStatisticsCommand,
+	TraceCommand,
+	NodeAuthorizationCheck,
+	RemoteNodeConnectionFailed,
+	SignalCaught,
+	RemoteNodeConnectionError,
+	CopySourceFileError,
+	CopyDestinationFileError,
+	CopyTranslationError,
+	SequentialFileError,
+	FileIOExitError,
+	SessionManagerError,
+	ProcessSyntaxError,
+	GetProcessCommand,
+	UnknownCommand,
+	ServerShutdown,
+}
@@ -0,0 +1,112 @@
+package parser_test
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/go-connect-direct/parser"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordIDs_Generated(t *testing.T) {
+	f, err := os.Open("records.csv")
+	require.NoError(t, err)
+	defer f.Close()
+
+	rows, err := csv.NewReader(f).ReadAll()
+	require.NoError(t, err)
+	require.Greater(t, len(rows), 1)
+
+	// records_gen.go must be regenerated with "go generate" after records.csv changes
+	for _, row := range rows[1:] {
+		rid := parser.LookupRecordID(row[1])
+		require.NotNil(t, rid, row[1])
+		require.True(t, rid.Known())
+		require.Equal(t, parser.RecordCategoy(row[2]), rid.Category, row[1])
+		require.Equal(t, row[3], rid.Description, row[1])
+	}
+}
+
+func TestRecordIDs_Categories(t *testing.T) {
+	groups := map[parser.RecordCategoy][]string{
+		// Records written while running a process, including errors named after the message ID
+		parser.CategoryProcess: {
+			"PSTR", "PRED", "PERR", "PRIN", "PFLS", "PSAV",
+			"LSST", "RSST", "CTRC", "IFED", "RJED", "RTED", "SBED", "PSED",
+			"CSPA", "USEC", "XCPK", "XIPT", "XCPS", "XCPR", "XCPZ", "XSQF", "FIOX", "XSMG", "XPAE",
+		},
+		// Commands, sessions and server lifecycle
+		parser.CategoryEvent: {
+			"SUBP", "CHCG", "CHGP", "DELP", "FLSP", "SELP", "SELS", "GPRC", "CUKN", "STOP", "TRAC", "TRON", "TROFF",
+			"SSTR", "SEND", "SERR", "RNCF", "QCEX", "QCWA", "QCTI", "QCHO",
+			"NUIC", "NUIS", "NUTR", "NUT1", "NUT2", "NUTC", "SHUD", "PMIP", "PMST", "PMED", "SMIN", "SMED",
+			"SLNM", "CHNM", "SLPX", "CHPX", "DLPX", "SLFA", "CHFA", "DLFA", "SLIP", "RFIP",
+		},
+		parser.CategoryExternalSource: {
+			"EXFA",
+		},
+	}
+	for category, codes := range groups {
+		for _, code := range codes {
+			rid := parser.LookupRecordID(code)
+			require.NotNil(t, rid, code)
+			require.Equal(t, category, rid.Category, code)
+		}
+	}
+}
+
+func TestRecordIDs_Unique(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, rid := range parser.NewRegistry().All() {
+		require.False(t, seen[rid.ID], "duplicate %s", rid.ID)
+		seen[rid.ID] = true
+	}
+
+	// Every row in records.csv is generated, so none were merged by a repeated ID
+	f, err := os.Open("records.csv")
+	require.NoError(t, err)
+	defer f.Close()
+
+	rows, err := csv.NewReader(f).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, seen, len(rows)-1)
+}
+
+func TestRecordIDs_Fixtures(t *testing.T) {
+	letters := map[parser.RecordCategoy]string{
+		parser.CategoryProcess:        "P",
+		parser.CategoryEvent:          "E",
+		parser.CategoryExternalSource: "X",
+	}
+
+	// ccode_extra_synthetic.txt is written by hand, so it only checks EXFA against the catalog's own category
+	for _, name := range []string{"ccode_stats.txt", "ccode_error.txt", "ccode_extra_synthetic.txt"} {
+		bs, err := os.ReadFile(filepath.Join("testdata", name))
+		require.NoError(t, err)
+
+		stats, err := parser.ParseCCode(string(bs))
+		require.NoError(t, err)
+		for _, stat := range stats.Stats {
+			require.True(t, stat.ID.Known(), "%s: %s", name, stat.ID.ID)
+			require.Equal(t, stat.Type, letters[stat.ID.Category], "%s: %s", name, stat.ID.ID)
+		}
+	}
+
+	bs, err := os.ReadFile(filepath.Join("testdata", "pnumber13_stats.txt"))
+	require.NoError(t, err)
+
+	stats, err := parser.ParseDetail(string(bs))
+	require.NoError(t, err)
+	for _, stat := range stats.Stats {
+		require.True(t, stat.ID.Known(), stat.ID.ID)
+		require.Equal(t, stat.Type, letters[stat.ID.Category], stat.ID.ID)
+	}
+}
+
+func TestRecordIDs_Unknown(t *testing.T) {
+	require.Nil(t, parser.LookupRecordID("ZZZZ"))
+	require.False(t, parser.RecordID{ID: "ZZZZ"}.Known())
+}
@@ -0,0 +1,179 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"iter"
+	"strings"
+)
+
+// Scanner reads summary output from an IBM Connect:Direct "select statistics" command one record at a time.
+//
+// It accepts the same input as ParseCCode but only holds a single record in memory, which makes it
+// suitable for large statistics dumps. Successive calls to Scan step through the records.
+//
+//	scanner := parser.NewScanner(r)
+//	for scanner.Scan() {
+//		stat := scanner.Stat()
+//	}
+//	if err := scanner.Err(); err != nil {
+//		// handle error
+//	}
+type Scanner struct {
+	lines    *bufio.Scanner
+	registry *Registry
+
+	// layouts are read from the header lines, keyed by record type
+	layouts map[string]*summaryLayout
+
+	shouldParseLine bool
+	done            bool
+
+	// pending is held until the next record is found, as it may be continued on following lines
+	pending    *SummaryStat
+	textColumn int
+
+	// width is the length of the hyphen row, which messages are cut at when they wrap mid-text.
+	// lastLength is the length of the pending record's last line.
+	width      int
+	lastLength int
+
+	stat SummaryStat
+	err  error
+}
+
+// NewScanner returns a Scanner reading summary statistics from r.
+func NewScanner(r io.Reader, opts ...Options) *Scanner {
+	lines := bufio.NewScanner(r)
+	lines.Buffer(make([]byte, 0, 4096), 1024*1024)
+
+	return &Scanner{
+		lines:    lines,
+		registry: registryFrom(opts),
+		layouts:  make(map[string]*summaryLayout),
+	}
+}
+
+// Scan advances to the next record, which is available from Stat. It returns false when
+// the input is exhausted or an error occurs.
+func (s *Scanner) Scan() bool {
+	if s.err != nil {
+		return false
+	}
+
+	for !s.done && s.lines.Scan() {
+		line := s.lines.Text()
+		raw := strings.TrimRight(line, " \t\r")
+		line = strings.TrimSpace(line)
+
+		// Find the row with a bunch of hyphens
+		if strings.Contains(line, "------") {
+			s.shouldParseLine = true
+			s.width = len(raw)
+		}
+		// Stop when we see a line of equals again
+		if s.shouldParseLine && strings.Contains(line, "======") {
+			s.done = true
+			break
+		}
+
+		// Skip lines when we aren't actively parsing, but keep where the header's columns start
+		//   P RECID LOG TIME            PNAME        PNUMBER  STEPNAME   CCOD FDBK MSGID
+		if !s.shouldParseLine {
+			if typ, layout := parseSummaryLayout(raw); layout != nil {
+				s.layouts[typ] = layout
+			}
+			continue
+		}
+
+		// Parse the line
+		// We've seen lines like the following:
+		//   E SUBP  02/03/2026 23:28:45 Submit command issued.
+		//   P PSTR  02/03/2026 23:28:45 sample            14                0      XSMG200I
+
+		// Messages of E records which are too long wrap onto an indented line starting at the text column.
+		// Lines are joined with a space, unless the message was cut at the full width of the output
+		//   E RNCF  02/05/2026 22:45:40 Attempt to connect to remote node frbpajcd02 failed
+		//                               . FRWL=N
+		indent := len(raw) - len(strings.TrimLeft(raw, " \t"))
+		if line != "" && s.textColumn > 0 && indent >= s.textColumn {
+			if s.pending.Type == "E" {
+				text := raw[s.textColumn:]
+				if s.lastLength < s.width {
+					text = " " + text
+				}
+				s.pending.Description += text
+				s.lastLength = len(raw)
+			}
+			continue
+		}
+		s.textColumn = 0
+
+		cols := strings.Fields(line)
+		if len(cols) < 2 {
+			continue // invalid line
+		}
+
+		rec, err := parseSummaryLine(raw, cols, s.layouts[cols[0]], s.registry)
+		if err != nil {
+			s.err = err
+			return false
+		}
+		if rec == nil {
+			continue
+		}
+
+		prev := s.pending
+		s.pending = rec
+		s.textColumn = summaryTextColumn(raw, cols)
+		s.lastLength = len(raw)
+
+		if prev != nil {
+			s.stat = *prev
+			return true
+		}
+	}
+	if err := s.lines.Err(); err != nil {
+		s.err = err
+		return false
+	}
+
+	// Return the final record
+	if s.pending != nil {
+		s.stat = *s.pending
+		s.pending = nil
+		s.textColumn = 0
+		return true
+	}
+	return false
+}
+
+// Stat returns the most recent record found by Scan.
+func (s *Scanner) Stat() SummaryStat {
+	return s.stat
+}
+
+// Err returns the first error encountered by the Scanner.
+func (s *Scanner) Err() error {
+	return s.err
+}
+
+// All returns an iterator over the remaining records. Iteration stops after the first error is yielded.
+//
+//	for stat, err := range parser.NewScanner(r).All() {
+//		if err != nil {
+//			return err
+//		}
+//	}
+func (s *Scanner) All() iter.Seq2[SummaryStat, error] {
+	return func(yield func(SummaryStat, error) bool) {
+		for s.Scan() {
+			if !yield(s.Stat(), nil) {
+				return
+			}
+		}
+		if err := s.Err(); err != nil {
+			yield(SummaryStat{}, err)
+		}
+	}
+}
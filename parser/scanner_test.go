@@ -0,0 +1,116 @@
+package parser_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/go-connect-direct/parser"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanner(t *testing.T) {
+	paths := []string{
+		filepath.Join("testdata", "ccode_stats.txt"),
+		filepath.Join("testdata", "ccode_error.txt"),
+		filepath.Join("testdata", "ccode_extra_synthetic.txt"),
+	}
+	for _, path := range paths {
+		t.Run(path, func(t *testing.T) {
+			bs, err := os.ReadFile(path)
+			require.NoError(t, err)
+
+			expected, err := parser.ParseCCode(string(bs))
+			require.NoError(t, err)
+
+			var got []parser.SummaryStat
+			scanner := parser.NewScanner(bytes.NewReader(bs))
+			for scanner.Scan() {
+				got = append(got, scanner.Stat())
+			}
+			require.NoError(t, scanner.Err())
+			require.Equal(t, expected.Stats, got)
+
+			// Scanning again should find nothing
+			require.False(t, scanner.Scan())
+
+			got = nil
+			for stat, err := range parser.NewScanner(bytes.NewReader(bs)).All() {
+				require.NoError(t, err)
+				got = append(got, stat)
+			}
+			require.Equal(t, expected.Stats, got)
+		})
+	}
+}
+
+func TestScanner_Error(t *testing.T) {
+	input := `
+-------------------------------------------------------------------------------
+E SUBP  02/03/2026 23:28:45 Submit command issued.
+P PSTR  02/33/2026 23:28:45 sample            14                0      XSMG200I
+===============================================================================
+`
+	scanner := parser.NewScanner(strings.NewReader(input))
+	require.False(t, scanner.Scan())
+	require.ErrorContains(t, scanner.Err(), "parsing process record")
+
+	var errs int
+	for _, err := range parser.NewScanner(strings.NewReader(input)).All() {
+		require.Error(t, err)
+		errs++
+	}
+	require.Equal(t, 1, errs)
+}
+
+func benchmarkInput(b *testing.B) string {
+	b.Helper()
+
+	bs, err := os.ReadFile(filepath.Join("testdata", "ccode_error.txt"))
+	require.NoError(b, err)
+
+	// Repeat the records from the fixture to build a large dump
+	input := string(bs)
+	start := strings.Index(input, "-\nP ") + len("-\n")
+	end := strings.LastIndex(input, "\n=") + len("\n")
+
+	var buf strings.Builder
+	buf.WriteString(input[:start])
+	for range 5000 {
+		buf.WriteString(input[start:end])
+	}
+	buf.WriteString(input[end:])
+	return buf.String()
+}
+
+func BenchmarkParseCCode(b *testing.B) {
+	input := benchmarkInput(b)
+	b.SetBytes(int64(len(input)))
+	b.ReportAllocs()
+
+	for b.Loop() {
+		_, err := parser.ParseCCode(input)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkScanner(b *testing.B) {
+	input := benchmarkInput(b)
+	b.SetBytes(int64(len(input)))
+	b.ReportAllocs()
+
+	for b.Loop() {
+		scanner := parser.NewScanner(strings.NewReader(input))
+		for scanner.Scan() {
+			_ = scanner.Stat()
+		}
+		if err := scanner.Err(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package parser
+
+import (
+	"strings"
+)
+
+// SecureSession holds the Secure+ (TLS) details of a session from detail SSTR and CTRC records.
+//
+//	Secure+ Protocol => TLSV13
+//	Cipher Suite     => TLS_AES_256_GCM_SHA384
+//	Security Mode    => FIPS 140-2
+//	Protocol Override     => N
+//	CipherSuite Override  => N
+//	Encrypt Data Override => N
+//	Certificate Subject   => (CN=fedach-dit,SN=36:21:b4:3e:4b:af:33:7d:b0:35:0
+//	                         1:85:af:57:60:39:41:60:a6:d3,FP=c1463ad1cd9213786
+//	                         f8f655694608b5d17decffd)
+//	Certificate Issuer    => (CN=fedach-dit)
+type SecureSession struct {
+	Protocol     string
+	CipherSuite  string
+	SecurityMode string
+
+	ProtocolOverride    bool
+	CipherSuiteOverride bool
+	EncryptDataOverride bool
+
+	Subject CertificateName
+	Issuer  CertificateName
+}
+
+// CertificateName is a certificate subject or issuer as logged by Connect:Direct.
+type CertificateName struct {
+	CommonName   string // CN
+	SerialNumber string // SN
+	Fingerprint  string // FP
+
+	// Raw is the full value, such as "(CN=fedach-dit,SN=36:21:b4,FP=c1463ad1)"
+	Raw string
+}
+
+// ProtocolTLS13 is the Secure+ Protocol value logged for TLS 1.3 sessions.
+const ProtocolTLS13 = "TLSV13"
+
+func parseSecureSession(fields map[string]string) *SecureSession {
+	if fields["Secure+ Protocol"] == "" {
+		return nil
+	}
+
+	return &SecureSession{
+		Protocol:     fields["Secure+ Protocol"],
+		CipherSuite:  fields["Cipher Suite"],
+		SecurityMode: fields["Security Mode"],
+
+		ProtocolOverride:    fields["Protocol Override"] == "Y",
+		CipherSuiteOverride: fields["CipherSuite Override"] == "Y",
+		EncryptDataOverride: fields["Encrypt Data Override"] == "Y",
+
+		Subject: parseCertificateName(fields["Certificate Subject"]),
+		Issuer:  parseCertificateName(fields["Certificate Issuer"]),
+	}
+}
+
+func parseCertificateName(value string) CertificateName {
+	out := CertificateName{
+		Raw: value,
+	}
+
+	value = strings.TrimSuffix(strings.TrimPrefix(value, "("), ")")
+	for _, part := range strings.Split(value, ",") {
+		key, val, found := strings.Cut(part, "=")
+		if !found {
+			continue
+		}
+		switch strings.ToUpper(strings.TrimSpace(key)) {
+		case "CN":
+			out.CommonName = val
+		case "SN":
+			out.SerialNumber = val
+		case "FP":
+			out.Fingerprint = val
+		}
+	}
+
+	return out
+}
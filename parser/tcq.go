@@ -0,0 +1,83 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+)
+
+// TCQQueue is a queue of the Transmission Control Queue (TCQ), named by the last two characters
+// of a TCQ change record ID such as QCEX.
+type TCQQueue string
+
+var (
+	TCQExecution TCQQueue = "EX"
+	TCQWait      TCQQueue = "WA"
+	TCQTimer     TCQQueue = "TI"
+	TCQHold      TCQQueue = "HO"
+)
+
+var tcqQueueNames = map[TCQQueue]string{
+	TCQExecution: "Execution",
+	TCQWait:      "Wait",
+	TCQTimer:     "Timer",
+	TCQHold:      "Hold",
+}
+
+// Name returns the name of the queue, such as Execution, or the code for unknown queues.
+func (q TCQQueue) Name() string {
+	if name, found := tcqQueueNames[q]; found {
+		return name
+	}
+	return string(q)
+}
+
+// Queue returns the queue a TCQ change record (QCxx) moved the process to, or an empty TCQQueue
+// for other records.
+func (r RecordID) Queue() TCQQueue {
+	code := strings.ToUpper(r.ID)
+	if len(code) != 4 || !strings.HasPrefix(code, "QC") || code == strings.ToUpper(TcqChange.ID) {
+		return ""
+	}
+	return TCQQueue(code[2:])
+}
+
+// TCQChange is read from the message text of a TCQ change record, such as
+// "TCQ queue change from WAIT to EXEC, status PE."
+type TCQChange struct {
+	From TCQQueue
+	To   TCQQueue
+
+	// Status is the process status on the new queue, such as PE (pending execution)
+	Status string
+}
+
+var tcqChangeRegex = regexp.MustCompile(`(?i)TCQ queue change from\s+(\S+)\s+to\s+([^\s,]+),?\s*status\s+([^\s.]+)`)
+
+// queues as they're written in message text
+var tcqMessageQueues = map[string]TCQQueue{
+	"EXEC":  TCQExecution,
+	"WAIT":  TCQWait,
+	"TIMER": TCQTimer,
+	"HOLD":  TCQHold,
+}
+
+// parseTCQChange returns nil when the text isn't a TCQ change message
+func parseTCQChange(text string) *TCQChange {
+	match := tcqChangeRegex.FindStringSubmatch(text)
+	if match == nil {
+		return nil
+	}
+	return &TCQChange{
+		From:   tcqMessageQueue(match[1]),
+		To:     tcqMessageQueue(match[2]),
+		Status: strings.ToUpper(match[3]),
+	}
+}
+
+func tcqMessageQueue(word string) TCQQueue {
+	word = strings.ToUpper(word)
+	if queue, found := tcqMessageQueues[word]; found {
+		return queue
+	}
+	return TCQQueue(word)
+}
@@ -0,0 +1,62 @@
+package parser_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/go-connect-direct/parser"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordID_Queue(t *testing.T) {
+	cases := map[string]parser.TCQQueue{
+		"QCEX": parser.TCQExecution,
+		"qcwa": parser.TCQWait,
+		"QCTI": parser.TCQTimer,
+		"QCHO": parser.TCQHold,
+	}
+	for code, queue := range cases {
+		rid := parser.LookupRecordID(code)
+		require.NotNil(t, rid, code)
+		require.Equal(t, parser.CategoryEvent, rid.Category)
+		require.Equal(t, queue, rid.Queue(), code)
+	}
+	require.Equal(t, "Execution", parser.TCQExecution.Name())
+	require.Equal(t, "Hold", parser.TCQHold.Name())
+
+	// QC codes outside the catalog keep their suffix
+	rid := parser.LookupRecordID("QCZZ")
+	require.NotNil(t, rid)
+	require.Equal(t, "QCZZ", rid.ID)
+	require.Equal(t, parser.TcqChange.Description, rid.Description)
+	require.Equal(t, parser.TCQQueue("ZZ"), rid.Queue())
+	require.Equal(t, "ZZ", rid.Queue().Name())
+
+	require.Equal(t, parser.TCQQueue(""), parser.TcqChange.Queue())
+	require.Equal(t, parser.TCQQueue(""), parser.CopyTerminationRecord.Queue())
+	require.Nil(t, parser.LookupRecordID("QCEXX"))
+}
+
+func TestParseDetail_TCQChange(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("testdata", "pnumber13_stats.txt"))
+	require.NoError(t, err)
+
+	got, err := parser.ParseDetail(string(bs))
+	require.NoError(t, err)
+
+	stat := got.Stats[0]
+	require.Equal(t, parser.TcqChangeExecution, stat.ID)
+	require.Equal(t, parser.TCQExecution, stat.ID.Queue())
+	require.NotNil(t, stat.TCQ)
+	require.Equal(t, parser.TCQChange{
+		From:   parser.TCQWait,
+		To:     parser.TCQExecution,
+		Status: "PE",
+	}, *stat.TCQ)
+
+	for _, stat := range got.Stats[1:] {
+		require.Nil(t, stat.TCQ, stat.ID.ID)
+	}
+}
@@ -0,0 +1,185 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Transcript is a captured Connect:Direct CLI session containing one or more commands.
+type Transcript struct {
+	Commands []Command
+}
+
+// Command is a single command issued at the "Direct>" prompt along with its output.
+type Command struct {
+	// Text is the command as it was entered, such as "sel stat ccode(ge,0) pnumber=21;"
+	Text string
+
+	// Output contains every line printed by the command
+	Output string
+
+	// Status is the line reporting the outcome of the command, such as
+	// "Select Statistics Completed Successfully."
+	Status string
+
+	// Summary or Detail are set for "select statistics" commands depending on
+	// whether the detail parameter was used.
+	Summary *SummaryStats
+	Detail  *DetailStats
+}
+
+// Succeeded returns true when the command reported it completed successfully.
+func (c Command) Succeeded() bool {
+	return strings.Contains(strings.ToLower(c.Status), "completed successfully")
+}
+
+// Failed returns the commands which reported a status other than completing successfully.
+// Commands which print no status line, such as "quit;", are not included.
+func (t Transcript) Failed() []Command {
+	var out []Command
+	for _, cmd := range t.Commands {
+		if cmd.Status != "" && !cmd.Succeeded() {
+			out = append(out, cmd)
+		}
+	}
+	return out
+}
+
+const prompt = "Direct>"
+
+// ParseTranscript splits a Connect:Direct CLI session on each "Direct>" prompt and parses the output of every command.
+//
+//	Direct> sel stat ccode(ge,0) pnumber=21;
+//	===============================================================================
+//	                           SELECT  STATISTICS
+//	===============================================================================
+//	...
+//	===============================================================================
+//	Select Statistics Completed Successfully.
+//	Direct> sel stat pnumber=13 detail;
+//
+// Output from "select statistics" commands is parsed with ParseCCode, or ParseDetail when the detail
+// parameter is used. Text before the first prompt and empty prompts are ignored.
+func ParseTranscript(input string, opts ...Options) (Transcript, error) {
+	var out Transcript
+
+	var current *Command
+	var output []string
+	var commandComplete bool
+
+	finish := func() error {
+		if current == nil {
+			return nil
+		}
+		current.Output = strings.Join(output, "\n")
+		output = nil
+
+		if err := current.parse(opts); err != nil {
+			return fmt.Errorf("parsing output of %q: %v", current.Text, err)
+		}
+		out.Commands = append(out.Commands, *current)
+		current = nil
+		return nil
+	}
+
+	lines := strings.Split(input, "\n")
+	for _, line := range lines {
+		line = strings.TrimRight(line, "\r")
+
+		// Only a prompt at the start of a line begins a command, as output may mention the prompt
+		if strings.HasPrefix(line, prompt) {
+			if err := finish(); err != nil {
+				return out, err
+			}
+
+			text := strings.TrimSpace(line[len(prompt):])
+			if text == "" {
+				continue
+			}
+			current = &Command{
+				Text: text,
+			}
+			commandComplete = strings.HasSuffix(text, ";")
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		// Commands can span multiple lines until they're terminated with a semicolon
+		if !commandComplete {
+			trimmed := strings.TrimSpace(line)
+			if !strings.HasPrefix(trimmed, "=====") {
+				current.Text += " " + trimmed
+				commandComplete = strings.HasSuffix(trimmed, ";")
+				continue
+			}
+			commandComplete = true
+		}
+
+		output = append(output, line)
+		if isCommandStatus(line) {
+			current.Status = strings.TrimSpace(line)
+		}
+	}
+	if err := finish(); err != nil {
+		return out, err
+	}
+
+	return out, nil
+}
+
+// isCommandStatus returns true for lines like "Select Statistics Completed Successfully."
+func isCommandStatus(line string) bool {
+	// Skip indented lines and records which can contain similar text
+	//   E RNCF  02/05/2026 22:45:40 Attempt to connect to remote node frbpajcd02 failed.
+	if line == "" || line[0] == ' ' || line[0] == '\t' || strings.Contains(line, "=>") {
+		return false
+	}
+	if cols := strings.Fields(line); len(cols) < 2 || len(cols[0]) == 1 {
+		return false
+	}
+
+	line = strings.ToLower(strings.TrimSpace(line))
+	return strings.HasSuffix(line, "completed successfully.") || strings.HasSuffix(line, "failed.")
+}
+
+func (c *Command) parse(opts []Options) error {
+	if !isSelectStatistics(c.Text) {
+		return nil
+	}
+
+	if isDetailCommand(c.Text) {
+		detail, err := ParseDetail(c.Output, opts...)
+		if err != nil {
+			return err
+		}
+		c.Detail = &detail
+		return nil
+	}
+
+	summary, err := ParseCCode(c.Output, opts...)
+	if err != nil {
+		return err
+	}
+	c.Summary = &summary
+	return nil
+}
+
+func isSelectStatistics(text string) bool {
+	fields := strings.Fields(strings.ToLower(strings.TrimSuffix(text, ";")))
+	if len(fields) < 2 {
+		return false
+	}
+	return (fields[0] == "sel" || fields[0] == "select") && strings.HasPrefix(fields[1], "stat")
+}
+
+func isDetailCommand(text string) bool {
+	fields := strings.Fields(strings.ToLower(strings.TrimSuffix(text, ";")))
+	for _, f := range fields[2:] {
+		if f == "detail" || f == "detail=yes" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package parser_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/go-connect-direct/parser"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTranscript(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("testdata", "transcript.txt"))
+	require.NoError(t, err)
+
+	got, err := parser.ParseTranscript(string(bs))
+	require.NoError(t, err)
+	require.Len(t, got.Commands, 6)
+
+	expected := []string{
+		"sel stat ccode(ge,0) pnumber=21;",
+		"sel stat ccode(le,4) pnumber=14;",
+		"sel stat pnumber=13 detail;",
+		"sel stat pnumber=13 startt=(02/31/2026);",
+		"sel stat pnumber=14;",
+		"quit;",
+	}
+	for idx, cmd := range got.Commands {
+		require.Equal(t, expected[idx], cmd.Text)
+	}
+
+	t.Run("summary", func(t *testing.T) {
+		cmd := got.Commands[0]
+		require.True(t, cmd.Succeeded())
+		require.Equal(t, "Select Statistics Completed Successfully.", cmd.Status)
+		require.NotNil(t, cmd.Summary)
+		require.Nil(t, cmd.Detail)
+		require.Len(t, cmd.Summary.Stats, 15)
+
+		cmd = got.Commands[1]
+		require.True(t, cmd.Succeeded())
+		require.Len(t, cmd.Summary.Stats, 8)
+
+		cmd = got.Commands[4]
+		require.True(t, cmd.Succeeded())
+		require.Len(t, cmd.Summary.Stats, 1)
+		require.Equal(t, parser.ProcessEnded, cmd.Summary.Stats[0].ID)
+	})
+
+	t.Run("detail", func(t *testing.T) {
+		cmd := got.Commands[2]
+		require.True(t, cmd.Succeeded())
+		require.Nil(t, cmd.Summary)
+		require.NotNil(t, cmd.Detail)
+		require.Len(t, cmd.Detail.Stats, 15)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		failed := got.Failed()
+		require.Len(t, failed, 1)
+		require.Equal(t, "sel stat pnumber=13 startt=(02/31/2026);", failed[0].Text)
+		require.Equal(t, "Select Statistics Failed.", failed[0].Status)
+		require.False(t, failed[0].Succeeded())
+		require.Empty(t, failed[0].Summary.Stats)
+	})
+
+	t.Run("other commands", func(t *testing.T) {
+		cmd := got.Commands[5]
+		require.False(t, cmd.Succeeded())
+		require.Empty(t, cmd.Status)
+		require.Nil(t, cmd.Summary)
+		require.Nil(t, cmd.Detail)
+	})
+}
+
+func TestParseTranscript_PromptInOutput(t *testing.T) {
+	input := strings.Join([]string{
+		"Direct> sel stat pnumber=22;",
+		"===============================================================================",
+		"                           SELECT  STATISTICS",
+		"===============================================================================",
+		"P RECID LOG TIME            PNAME        PNUMBER  STEPNAME   CCOD FDBK MSGID",
+		"E RECID LOG TIME            MESSAGE TEXT",
+		"-------------------------------------------------------------------------------",
+		"E SIGC  02/05/2026 22:45:40 Direct> prompt closed by the operator while",
+		"                            Direct> was waiting for input.",
+		"===============================================================================",
+		"Select Statistics Completed Successfully.",
+		"Direct> quit;",
+	}, "\n")
+
+	got, err := parser.ParseTranscript(input)
+	require.NoError(t, err)
+	require.Len(t, got.Commands, 2)
+	require.Equal(t, "sel stat pnumber=22;", got.Commands[0].Text)
+	require.Len(t, got.Commands[0].Summary.Stats, 1)
+	require.Equal(t, "Direct> prompt closed by the operator while Direct> was waiting for input.", got.Commands[0].Summary.Stats[0].Description)
+	require.Equal(t, "quit;", got.Commands[1].Text)
+}
@@ -0,0 +1,160 @@
+// Package process reads and writes the IBM Connect:Direct Process language.
+//
+// A process file contains a PROCESS statement followed by steps and ends with PEND:
+//
+//	sample   process  snode=cdnode
+//	                  &FILE=/data/outbound/ach.txt
+//	step01   copy from (file=&FILE pnode)
+//	              to (file=/data/inbound/ach.txt snode disp=rpl)
+//	         if (step01 eq 0) then
+//	            run task (pgm=UNIX) snode
+//	                     sysopts="touch /data/outbound/ach.done"
+//	         eif
+//	         pend
+package process
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Pos is a location within process text. Line and Column start at 1.
+type Pos struct {
+	Line   int
+	Column int
+}
+
+func (p Pos) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// Process is the root of a parsed process file.
+type Process struct {
+	Pos  Pos
+	Name string
+
+	// Params are the PROCESS statement parameters, such as snode=cdnode or &FILE=default
+	Params []Param
+
+	Statements []Statement
+}
+
+// Param is a parameter such as "snode=cdnode", "from (file=&FILE pnode)" or a flag like "pnode".
+type Param struct {
+	Pos  Pos
+	Name string
+
+	// Value is nil for flags. Positional values, such as "(pgm=UNIX)" after RUN TASK, have an empty Name.
+	Value *Value
+}
+
+// Value is the value of a Param, either a single word, a quoted string or a parenthesized list.
+type Value struct {
+	Pos Pos
+
+	// Text is the word or string contents without quotes
+	Text string
+
+	// Quote is the character which surrounded Text, or zero when it was not quoted
+	Quote rune
+
+	// List is set for values in parentheses
+	List   []Param
+	IsList bool
+}
+
+// Statement is one of *Step, *If, *Goto or *Exit
+type Statement interface {
+	Position() Pos
+	StatementLabel() string
+}
+
+// Step is a COPY, RUN TASK, RUN JOB, SUBMIT or SYMBOL statement.
+type Step struct {
+	Pos   Pos
+	Label string
+
+	// Keyword is the lowercase statement name such as "copy" or "run task"
+	Keyword string
+
+	Params []Param
+}
+
+// If is a conditional block ending with EIF
+//
+//	if (step01 eq 0) then
+//	   ...
+//	else
+//	   ...
+//	eif
+type If struct {
+	Pos       Pos
+	Label     string
+	Condition Condition
+
+	Then []Statement
+	Else []Statement
+
+	// HasElse is true when an ELSE statement was present, even with no statements following it
+	HasElse bool
+}
+
+// Condition compares the completion code of a step, such as "(step01 eq 0)"
+type Condition struct {
+	Pos  Pos
+	Step string
+
+	// Operator is one of eq, ne, gt, ge, lt, or le
+	Operator string
+	Value    string
+}
+
+// Goto continues processing at the step with Target as its label.
+type Goto struct {
+	Pos    Pos
+	Label  string
+	Target string
+}
+
+// Exit ends the process.
+type Exit struct {
+	Pos   Pos
+	Label string
+}
+
+func (s *Step) Position() Pos { return s.Pos }
+func (s *If) Position() Pos   { return s.Pos }
+func (s *Goto) Position() Pos { return s.Pos }
+func (s *Exit) Position() Pos { return s.Pos }
+
+func (s *Step) StatementLabel() string { return s.Label }
+func (s *If) StatementLabel() string   { return s.Label }
+func (s *Goto) StatementLabel() string { return s.Label }
+func (s *Exit) StatementLabel() string { return s.Label }
+
+// Param returns the first parameter with a matching name, compared without case.
+func (s *Step) Param(name string) *Param {
+	return findParam(s.Params, name)
+}
+
+// Param returns the first PROCESS parameter with a matching name, compared without case.
+func (p *Process) Param(name string) *Param {
+	return findParam(p.Params, name)
+}
+
+// Param returns the first list element with a matching name, compared without case.
+func (v *Value) Param(name string) *Param {
+	if v == nil {
+		return nil
+	}
+	return findParam(v.List, name)
+}
+
+func findParam(params []Param, name string) *Param {
+	for i := range params {
+		if strings.EqualFold(params[i].Name, name) {
+			return &params[i]
+		}
+	}
+	return nil
+}
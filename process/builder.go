@@ -0,0 +1,364 @@
+package process
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Node selects which side of a session a file or task belongs to.
+type Node string
+
+const (
+	PNode Node = "pnode"
+	SNode Node = "snode"
+)
+
+// Compression is the data compression used by a COPY step.
+type Compression string
+
+const (
+	CompressPrimary  Compression = "compress"
+	CompressExtended Compression = "compress extended"
+)
+
+// File is the source or destination of a COPY step.
+type File struct {
+	// Path is the file name, which can be a symbolic variable such as &FILE
+	Path string
+	Node Node
+
+	// Disp is the destination disposition, such as "new", "mod" or "rpl"
+	Disp string
+
+	// SysOpts are platform specific options, such as ":datatype=text:"
+	SysOpts string
+}
+
+// CopyOptions are the optional parameters of a COPY step.
+type CopyOptions struct {
+	// Checkpoint is the checkpoint interval, such as "1M" or "512K"
+	Checkpoint string
+
+	Compression Compression
+}
+
+// Builder creates a Process from typed steps so that values are always quoted correctly.
+//
+//	text, err := process.New("sample").
+//		SNode("cdnode").
+//		Symbolic("&FILE", "/data/outbound/ach.txt").
+//		Copy("step01", process.File{Path: "&FILE", Node: process.PNode}, process.File{Path: "/data/inbound/ach.txt", Node: process.SNode, Disp: "rpl"}).
+//		If("", "step01", "ne", 0).
+//		Exit("").
+//		EIf().
+//		String()
+//
+// The first error is kept and returned from Build or String.
+type Builder struct {
+	proc Process
+	err  error
+
+	// blocks are the IF statements which have not been closed with EIf
+	blocks []*If
+}
+
+// New starts a process with the given name, which must be one to eight characters.
+func New(name string) *Builder {
+	b := &Builder{
+		proc: Process{Name: name},
+	}
+	if err := validateLabel(name); err != nil {
+		b.err = fmt.Errorf("invalid process name: %w", err)
+	}
+	return b
+}
+
+// SNode sets the secondary node of the process.
+func (b *Builder) SNode(node string) *Builder {
+	return b.Param("snode", node)
+}
+
+// Param adds a PROCESS statement parameter, such as "hold" or "class".
+func (b *Builder) Param(name, value string) *Builder {
+	b.proc.Params = append(b.proc.Params, b.param(name, value))
+	return b
+}
+
+// Symbolic declares a symbolic variable, such as "&FILE", with its default value.
+func (b *Builder) Symbolic(name, value string) *Builder {
+	if !strings.HasPrefix(name, "&") {
+		name = "&" + name
+	}
+	if err := ValidateSymbolic(name); err != nil {
+		b.setErr(fmt.Errorf("invalid symbolic: %w", err))
+	}
+	return b.Param(name, value)
+}
+
+// Copy adds a COPY step from one file to another.
+func (b *Builder) Copy(label string, from, to File, opts ...CopyOptions) *Builder {
+	params := []Param{
+		{Name: "from", Value: b.fileList(from)},
+		{Name: "to", Value: b.fileList(to)},
+	}
+	for _, opt := range opts {
+		if opt.Checkpoint != "" {
+			params = append(params, b.param("ckpt", opt.Checkpoint))
+		}
+		switch opt.Compression {
+		case "":
+		case CompressPrimary, CompressExtended:
+			for _, flag := range strings.Fields(string(opt.Compression)) {
+				params = append(params, Param{Name: flag})
+			}
+		default:
+			b.setErr(fmt.Errorf("unknown compression %q", opt.Compression))
+		}
+	}
+	return b.add(&Step{Label: label, Keyword: "copy", Params: params})
+}
+
+// RunTask adds a RUN TASK step which runs a command on the given node.
+func (b *Builder) RunTask(label string, node Node, sysopts string) *Builder {
+	params := []Param{
+		{Value: &Value{IsList: true, List: []Param{b.param("pgm", "UNIX")}}},
+	}
+	if node != "" {
+		params = append(params, b.node(node))
+	}
+	params = append(params, b.param("sysopts", sysopts))
+	return b.add(&Step{Label: label, Keyword: "run task", Params: params})
+}
+
+// Submit adds a SUBMIT step which starts another process file. Symbolics are written in the order given
+// as name and value pairs, such as "&STATUS", "ok".
+func (b *Builder) Submit(label, file string, symbolics ...string) *Builder {
+	if len(symbolics)%2 != 0 {
+		b.setErr(fmt.Errorf("symbolics for %s must be name and value pairs", file))
+		symbolics = symbolics[:len(symbolics)-1]
+	}
+	params := []Param{b.param("file", file)}
+	for i := 0; i < len(symbolics); i += 2 {
+		name := symbolics[i]
+		if !strings.HasPrefix(name, "&") {
+			name = "&" + name
+		}
+		if err := ValidateSymbolic(name); err != nil {
+			b.setErr(fmt.Errorf("invalid symbolic: %w", err))
+		}
+		params = append(params, b.param(name, symbolics[i+1]))
+	}
+	return b.add(&Step{Label: label, Keyword: "submit", Params: params})
+}
+
+// If starts a conditional block on the completion code of a step, such as If("", "step01", "eq", 0).
+// Statements added afterwards are part of the block until Else or EIf.
+func (b *Builder) If(label, step, operator string, code int) *Builder {
+	op, ok := operators[strings.ToLower(operator)]
+	if !ok {
+		b.setErr(fmt.Errorf("invalid IF operator %q", operator))
+	}
+	if err := validateLabel(step); err != nil {
+		b.setErr(fmt.Errorf("invalid IF step %q: %w", step, err))
+	}
+	stmt := &If{
+		Label: label,
+		Condition: Condition{
+			Step:     step,
+			Operator: op,
+			Value:    fmt.Sprintf("%d", code),
+		},
+	}
+	b.add(stmt)
+	b.blocks = append(b.blocks, stmt)
+	return b
+}
+
+// Else switches the current IF block to the statements run when its condition is false.
+func (b *Builder) Else() *Builder {
+	if len(b.blocks) == 0 {
+		b.setErr(errors.New("ELSE without IF"))
+		return b
+	}
+	block := b.blocks[len(b.blocks)-1]
+	if block.HasElse {
+		b.setErr(errors.New("IF already has ELSE"))
+	}
+	block.HasElse = true
+	return b
+}
+
+// EIf closes the current IF block.
+func (b *Builder) EIf() *Builder {
+	if len(b.blocks) == 0 {
+		b.setErr(errors.New("EIF without IF"))
+		return b
+	}
+	b.blocks = b.blocks[:len(b.blocks)-1]
+	return b
+}
+
+// Goto continues the process at the step labeled target.
+func (b *Builder) Goto(label, target string) *Builder {
+	if err := validateLabel(target); err != nil {
+		b.setErr(fmt.Errorf("invalid GOTO target %q: %w", target, err))
+	}
+	return b.add(&Goto{Label: label, Target: target})
+}
+
+// Exit ends the process.
+func (b *Builder) Exit(label string) *Builder {
+	return b.add(&Exit{Label: label})
+}
+
+// Build returns the Process or the first error found while building it.
+//
+// The process is formatted and parsed again to confirm the text is valid Process language.
+func (b *Builder) Build() (*Process, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if len(b.blocks) > 0 {
+		return nil, errors.New("IF is missing EIF")
+	}
+
+	labels := make(map[string]bool)
+	var targets []string
+	walkStatements(b.proc.Statements, func(stmt Statement) {
+		if label := stmt.StatementLabel(); label != "" {
+			labels[strings.ToLower(label)] = true
+		}
+		if g, ok := stmt.(*Goto); ok {
+			targets = append(targets, g.Target)
+		}
+	})
+	for _, target := range targets {
+		if !labels[strings.ToLower(target)] {
+			return nil, fmt.Errorf("GOTO target %s is not a step label", target)
+		}
+	}
+
+	proc := b.proc
+	if _, err := Parse(Format(&proc)); err != nil {
+		return nil, fmt.Errorf("generated invalid process: %w", err)
+	}
+	return &proc, nil
+}
+
+// String returns the formatted process text or the first error found while building it.
+func (b *Builder) String() (string, error) {
+	proc, err := b.Build()
+	if err != nil {
+		return "", err
+	}
+	return Format(proc), nil
+}
+
+func (b *Builder) setErr(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+func (b *Builder) add(stmt Statement) *Builder {
+	if label := stmt.StatementLabel(); label != "" {
+		if err := validateLabel(label); err != nil {
+			b.setErr(fmt.Errorf("invalid label %q: %w", label, err))
+		}
+	}
+
+	if len(b.blocks) == 0 {
+		b.proc.Statements = append(b.proc.Statements, stmt)
+		return b
+	}
+	block := b.blocks[len(b.blocks)-1]
+	if block.HasElse {
+		block.Else = append(block.Else, stmt)
+	} else {
+		block.Then = append(block.Then, stmt)
+	}
+	return b
+}
+
+func (b *Builder) param(name, value string) Param {
+	v, err := quoteValue(value)
+	if err != nil {
+		b.setErr(fmt.Errorf("invalid %s: %w", name, err))
+	}
+	return Param{Name: name, Value: v}
+}
+
+func (b *Builder) node(node Node) Param {
+	if node != PNode && node != SNode {
+		b.setErr(fmt.Errorf("unknown node %q", node))
+	}
+	return Param{Name: string(node)}
+}
+
+func (b *Builder) fileList(file File) *Value {
+	if file.Path == "" {
+		b.setErr(errors.New("missing COPY file"))
+	}
+	out := &Value{IsList: true}
+	out.List = append(out.List, b.param("file", file.Path))
+	if file.Node != "" {
+		out.List = append(out.List, b.node(file.Node))
+	}
+	if file.Disp != "" {
+		out.List = append(out.List, b.param("disp", file.Disp))
+	}
+	if file.SysOpts != "" {
+		out.List = append(out.List, b.param("sysopts", file.SysOpts))
+	}
+	return out
+}
+
+// quoteValue returns a word when value has no special characters, otherwise a string in double
+// quotes, or single quotes when value contains a double quote.
+func quoteValue(value string) (*Value, error) {
+	switch {
+	case value == "":
+		return nil, errors.New("empty value")
+	case strings.ContainsAny(value, "\r\n"):
+		return nil, fmt.Errorf("%q contains a newline", value)
+	case !strings.ContainsAny(value, " \t=(),<>!'\"") && !strings.Contains(value, "/*"):
+		return &Value{Text: value}, nil
+	case !strings.Contains(value, `"`):
+		return &Value{Text: value, Quote: '"'}, nil
+	case !strings.Contains(value, "'"):
+		return &Value{Text: value, Quote: '\''}, nil
+	}
+	return nil, fmt.Errorf("%q contains both single and double quotes", value)
+}
+
+// validateLabel checks process names and step labels, which are one to eight characters
+// starting with a letter.
+func validateLabel(label string) error {
+	if label == "" || len(label) > labelWidth {
+		return fmt.Errorf("%q must be 1 to %d characters", label, labelWidth)
+	}
+	for i, r := range label {
+		letter := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		if i == 0 && !letter {
+			return fmt.Errorf("%q must start with a letter", label)
+		}
+		if !letter && !(r >= '0' && r <= '9') {
+			return fmt.Errorf("%q must be letters and digits", label)
+		}
+	}
+	if keywords[strings.ToLower(label)] {
+		return fmt.Errorf("%q is a keyword", label)
+	}
+	return nil
+}
+
+func walkStatements(stmts []Statement, fn func(Statement)) {
+	for _, stmt := range stmts {
+		fn(stmt)
+		if s, ok := stmt.(*If); ok {
+			walkStatements(s.Then, fn)
+			walkStatements(s.Else, fn)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package process_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/go-connect-direct/process"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilder(t *testing.T) {
+	text, err := process.New("ACHSEND").
+		SNode("fedach-dit").
+		Param("hold", "no").
+		Symbolic("&FILE", "/data/outbound/ach.txt").
+		Symbolic("DEST", "/data/inbound/ach file.txt").
+		Copy("step01",
+			process.File{Path: "&FILE", Node: process.PNode},
+			process.File{Path: "&DEST", Node: process.SNode, Disp: "rpl", SysOpts: ":datatype=text:"},
+			process.CopyOptions{Checkpoint: "1M", Compression: process.CompressExtended},
+		).
+		If("", "step01", "=", 0).
+		RunTask("step02", process.SNode, "mv /data/outbound/ach.txt /data/archive/").
+		Else().
+		RunTask("", process.PNode, `echo "copy failed"`).
+		Goto("", "failed").
+		EIf().
+		Submit("", "/opt/cdunix/ndm/process/notify.cdp", "&STATUS", "ok").
+		Exit("").
+		Submit("failed", "/opt/cdunix/ndm/process/notify.cdp", "STATUS", "failed").
+		String()
+	require.NoError(t, err)
+
+	proc, err := process.Parse(text)
+	require.NoError(t, err)
+	require.Equal(t, ":datatype=text:", proc.Statements[0].(*process.Step).Param("to").Value.Param("sysopts").Value.Text)
+
+	// The expected Process is written by hand in another layout, so only the parsed statements are compared
+	bs, err := os.ReadFile(filepath.Join("testdata", "builder.cdp"))
+	require.NoError(t, err)
+	expected, err := process.Parse(string(bs))
+	require.NoError(t, err)
+
+	clearPositions(expected)
+	clearPositions(proc)
+	require.Equal(t, expected, proc)
+}
+
+// clearPositions zeroes every Pos within a Process, so processes with different layouts can be compared
+func clearPositions(proc *process.Process) {
+	proc.Pos = process.Pos{}
+	clearParamPositions(proc.Params)
+	clearStatementPositions(proc.Statements)
+}
+
+func clearStatementPositions(stmts []process.Statement) {
+	for _, stmt := range stmts {
+		switch s := stmt.(type) {
+		case *process.Step:
+			s.Pos = process.Pos{}
+			clearParamPositions(s.Params)
+		case *process.If:
+			s.Pos = process.Pos{}
+			s.Condition.Pos = process.Pos{}
+			clearStatementPositions(s.Then)
+			clearStatementPositions(s.Else)
+		case *process.Goto:
+			s.Pos = process.Pos{}
+		case *process.Exit:
+			s.Pos = process.Pos{}
+		}
+	}
+}
+
+func clearParamPositions(params []process.Param) {
+	for i := range params {
+		params[i].Pos = process.Pos{}
+		if v := params[i].Value; v != nil {
+			v.Pos = process.Pos{}
+			clearParamPositions(v.List)
+		}
+	}
+}
+
+func TestBuilder_Errors(t *testing.T) {
+	from := process.File{Path: "/tmp/a", Node: process.PNode}
+	to := process.File{Path: "/tmp/b", Node: process.SNode}
+
+	cases := []struct {
+		builder  *process.Builder
+		expected string
+	}{
+		{process.New("toolongname"), `invalid process name: "toolongname" must be 1 to 8 characters`},
+		{process.New("1proc"), `invalid process name: "1proc" must start with a letter`},
+		{process.New("sample").Symbolic("&BAD-NAME", "x"), `invalid symbolic: "&BAD-NAME" must be letters, digits and underscores`},
+		{process.New("sample").Symbolic("&ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456", "x"), `invalid symbolic: "&ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456" must be 1 to 32 characters after the &`},
+		{process.New("sample").Submit("step01", "a.cdp", "&1ST", "x"), `invalid symbolic: "&1ST" must start with a letter after the &`},
+		{process.New("sample").Copy("copy", from, to), `invalid label "copy": "copy" is a keyword`},
+		{process.New("sample").Copy("step01", process.File{}, to), "missing COPY file"},
+		{process.New("sample").Copy("step01", from, to, process.CopyOptions{Compression: "zip"}), `unknown compression "zip"`},
+		{process.New("sample").RunTask("step01", process.PNode, `echo "it's"`), `invalid sysopts: "echo \"it's\"" contains both single and double quotes`},
+		{process.New("sample").RunTask("step01", "xnode", "ls"), `unknown node "xnode"`},
+		{process.New("sample").Submit("step01", "a.cdp", "&ONLY"), "symbolics for a.cdp must be name and value pairs"},
+		{process.New("sample").Submit("step01", "a.cdp", "&A", "line\nbreak"), `invalid &A: "line\nbreak" contains a newline`},
+		{process.New("sample").If("", "step01", "is", 0).EIf(), `invalid IF operator "is"`},
+		{process.New("sample").If("", "step01", "eq", 0).Exit(""), "IF is missing EIF"},
+		{process.New("sample").Else(), "ELSE without IF"},
+		{process.New("sample").EIf(), "EIF without IF"},
+		{process.New("sample").If("", "step01", "eq", 0).Else().Else().EIf(), "IF already has ELSE"},
+		{process.New("sample").Goto("", "missing"), "GOTO target missing is not a step label"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.expected, func(t *testing.T) {
+			_, err := tc.builder.Build()
+			require.EqualError(t, err, tc.expected)
+		})
+	}
+}
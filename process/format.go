@@ -0,0 +1,109 @@
+package process
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	labelWidth  = 8
+	nestedWidth = 3
+)
+
+// Format writes a Process as canonical Process language text.
+//
+// Labels are written in the first column and padded to eight characters, keywords are lowercase
+// and each parameter after the first is written on its own line aligned with the first, except for
+// flags which follow the parameter before them. Statements within IF blocks are indented by three spaces.
+func Format(proc *Process) string {
+	var buf strings.Builder
+
+	writeStatement(&buf, proc.Name, 0, "process ", proc.Params)
+	writeStatements(&buf, proc.Statements, 0)
+	writeStatement(&buf, "", 0, "pend", nil)
+
+	return buf.String()
+}
+
+func writeStatements(buf *strings.Builder, stmts []Statement, depth int) {
+	for _, stmt := range stmts {
+		switch s := stmt.(type) {
+		case *Step:
+			writeStatement(buf, s.Label, depth, s.Keyword, s.Params)
+
+		case *If:
+			cond := fmt.Sprintf("if (%s %s %s) then", s.Condition.Step, s.Condition.Operator, s.Condition.Value)
+			writeStatement(buf, s.Label, depth, cond, nil)
+			writeStatements(buf, s.Then, depth+1)
+			if s.HasElse || len(s.Else) > 0 {
+				writeStatement(buf, "", depth, "else", nil)
+				writeStatements(buf, s.Else, depth+1)
+			}
+			writeStatement(buf, "", depth, "eif", nil)
+
+		case *Goto:
+			writeStatement(buf, s.Label, depth, "goto "+s.Target, nil)
+
+		case *Exit:
+			writeStatement(buf, s.Label, depth, "exit", nil)
+		}
+	}
+}
+
+func writeStatement(buf *strings.Builder, label string, depth int, keyword string, params []Param) {
+	line := fmt.Sprintf("%-*s %s%s", labelWidth, label, strings.Repeat(" ", depth*nestedWidth), keyword)
+	if len(params) == 0 {
+		buf.WriteString(strings.TrimRight(line, " ") + "\n")
+		return
+	}
+
+	line += " "
+	indent := strings.Repeat(" ", len(line))
+	for i, param := range params {
+		switch {
+		case i == 0:
+			buf.WriteString(line)
+		case param.Value == nil:
+			// Keep flags with the parameter before them, such as "ckpt=1M compress ext"
+			buf.WriteString(" ")
+		default:
+			buf.WriteString("\n" + indent)
+		}
+		buf.WriteString(formatParam(param))
+	}
+	buf.WriteString("\n")
+}
+
+// formatParam writes a parameter. COPY FROM and TO lists are written without an equals sign.
+func formatParam(param Param) string {
+	if param.Value == nil {
+		return param.Name
+	}
+	if param.Name == "" {
+		return formatValue(*param.Value)
+	}
+	if param.Value.IsList && (strings.EqualFold(param.Name, "from") || strings.EqualFold(param.Name, "to")) {
+		return param.Name + " " + formatValue(*param.Value)
+	}
+	return param.Name + "=" + formatValue(*param.Value)
+}
+
+func formatValue(value Value) string {
+	if !value.IsList {
+		if value.Quote != 0 {
+			return string(value.Quote) + value.Text + string(value.Quote)
+		}
+		return value.Text
+	}
+
+	// Lists of plain values are separated by commas, otherwise spaces
+	sep := ","
+	items := make([]string, len(value.List))
+	for i, item := range value.List {
+		if item.Name != "" && item.Value != nil {
+			sep = " "
+		}
+		items[i] = formatParam(item)
+	}
+	return "(" + strings.Join(items, sep) + ")"
+}
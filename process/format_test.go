@@ -0,0 +1,30 @@
+package process_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/go-connect-direct/process"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormat(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("testdata", "sample.cdp"))
+	require.NoError(t, err)
+
+	proc, err := process.Parse(string(bs))
+	require.NoError(t, err)
+
+	golden, err := os.ReadFile(filepath.Join("testdata", "sample.golden"))
+	require.NoError(t, err)
+
+	formatted := process.Format(proc)
+	require.Equal(t, string(golden), formatted)
+
+	// Canonical text should parse and format to itself
+	again, err := process.Parse(formatted)
+	require.NoError(t, err)
+	require.Equal(t, formatted, process.Format(again))
+}
@@ -0,0 +1,191 @@
+package process
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenEquals
+	tokenLParen
+	tokenRParen
+	tokenComma
+	tokenOperator // <, >, !, and combinations of them
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	quote rune
+	pos   Pos
+
+	// first is true for the first token on a line, which is where statements begin
+	first bool
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenString:
+		return string(t.quote) + t.text + string(t.quote)
+	}
+	return t.text
+}
+
+// Error is a syntax error found at Pos.
+type Error struct {
+	Pos Pos
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Pos, e.Msg)
+}
+
+func errorf(pos Pos, format string, args ...interface{}) *Error {
+	return &Error{Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func lex(src string) ([]token, error) {
+	var out []token
+
+	runes := []rune(src)
+	line, col := 1, 1
+	first := true
+
+	advance := func() {
+		if runes[0] == '\n' {
+			line++
+			col = 1
+			first = true
+		} else {
+			col++
+		}
+		runes = runes[1:]
+	}
+
+	for len(runes) > 0 {
+		r := runes[0]
+		pos := Pos{Line: line, Column: col}
+
+		switch {
+		case r == ' ' || r == '\t' || r == '\r' || r == '\n':
+			advance()
+			continue
+
+		case r == '/' && len(runes) > 1 && runes[1] == '*':
+			// Skip comments, looking for the end after the opening /* so /*/ doesn't close itself
+			advance()
+			advance()
+			for len(runes) > 0 && !(runes[0] == '*' && len(runes) > 1 && runes[1] == '/') {
+				advance()
+			}
+			if len(runes) == 0 {
+				return nil, errorf(pos, "unterminated comment")
+			}
+			advance()
+			advance()
+			continue
+		}
+
+		tok := token{pos: pos, first: first}
+		first = false
+
+		switch {
+		case r == '"' || r == '\'':
+			text, ok := readQuoted(runes)
+			if !ok {
+				return nil, errorf(pos, "unterminated string")
+			}
+			for range len([]rune(text)) + 2 {
+				advance()
+			}
+			tok.kind = tokenString
+			tok.text = text
+			tok.quote = r
+
+		case r == '=':
+			tok.kind = tokenEquals
+			tok.text = "="
+			advance()
+
+		case r == '(':
+			tok.kind = tokenLParen
+			tok.text = "("
+			advance()
+
+		case r == ')':
+			tok.kind = tokenRParen
+			tok.text = ")"
+			advance()
+
+		case r == ',':
+			tok.kind = tokenComma
+			tok.text = ","
+			advance()
+
+		case isOperator(r):
+			var buf strings.Builder
+			for len(runes) > 0 && (isOperator(runes[0]) || (buf.Len() > 0 && runes[0] == '=')) {
+				buf.WriteRune(runes[0])
+				advance()
+			}
+			tok.kind = tokenOperator
+			tok.text = buf.String()
+
+		default:
+			// Words can contain quoted sections, such as x'0a'
+			var buf strings.Builder
+			for len(runes) > 0 && isWord(runes[0]) {
+				if runes[0] == '"' || runes[0] == '\'' {
+					text, ok := readQuoted(runes)
+					if !ok {
+						return nil, errorf(Pos{Line: line, Column: col}, "unterminated string")
+					}
+					quoted := string(runes[0]) + text + string(runes[0])
+					buf.WriteString(quoted)
+					for range len([]rune(quoted)) {
+						advance()
+					}
+					continue
+				}
+				buf.WriteRune(runes[0])
+				advance()
+			}
+			tok.kind = tokenWord
+			tok.text = buf.String()
+		}
+
+		out = append(out, tok)
+	}
+
+	return out, nil
+}
+
+// readQuoted returns the text between the quote at runes[0] and its closing quote
+func readQuoted(runes []rune) (string, bool) {
+	for i := 1; i < len(runes); i++ {
+		if runes[i] == runes[0] {
+			return string(runes[1:i]), true
+		}
+		if runes[i] == '\n' {
+			return "", false
+		}
+	}
+	return "", false
+}
+
+func isOperator(r rune) bool {
+	return r == '<' || r == '>' || r == '!'
+}
+
+func isWord(r rune) bool {
+	switch r {
+	case ' ', '\t', '\r', '\n', '=', '(', ')', ',':
+		return false
+	}
+	return !isOperator(r)
+}
@@ -0,0 +1,410 @@
+package process
+
+import (
+	"slices"
+	"strings"
+)
+
+// Parse reads Connect:Direct Process language into a Process.
+//
+// Statements begin on a new line with an optional label followed by a keyword, and continue onto
+// following lines until the next statement. Comments are written between /* and */.
+//
+// Syntax errors are returned as an *Error which includes the line and column.
+func Parse(src string) (*Process, error) {
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &processParser{tokens: tokens}
+	return p.parseProcess()
+}
+
+type processParser struct {
+	tokens []token
+	idx    int
+}
+
+var keywords = map[string]bool{
+	"process": true,
+	"copy":    true,
+	"run":     true,
+	"submit":  true,
+	"symbol":  true,
+	"if":      true,
+	"else":    true,
+	"eif":     true,
+	"goto":    true,
+	"exit":    true,
+	"pend":    true,
+}
+
+func isKeyword(tok token) bool {
+	return tok.kind == tokenWord && keywords[strings.ToLower(tok.text)]
+}
+
+func (p *processParser) peek() *token {
+	return p.peekAt(0)
+}
+
+func (p *processParser) peekAt(n int) *token {
+	if p.idx+n < len(p.tokens) {
+		return &p.tokens[p.idx+n]
+	}
+	return nil
+}
+
+func (p *processParser) next() *token {
+	tok := p.peek()
+	if tok != nil {
+		p.idx++
+	}
+	return tok
+}
+
+func (p *processParser) eofPos() Pos {
+	if len(p.tokens) == 0 {
+		return Pos{Line: 1, Column: 1}
+	}
+	last := p.tokens[len(p.tokens)-1]
+	return Pos{Line: last.pos.Line, Column: last.pos.Column + len([]rune(last.String()))}
+}
+
+// atStatement returns true when the next token begins a statement, either with a keyword
+// or a label followed by a keyword on the same line.
+func (p *processParser) atStatement() bool {
+	tok := p.peek()
+	if tok == nil || !tok.first {
+		return false
+	}
+	if isKeyword(*tok) {
+		return true
+	}
+	next := p.peekAt(1)
+	return tok.kind == tokenWord && next != nil && !next.first && isKeyword(*next)
+}
+
+// statementStart reads the optional label and keyword at the start of a statement
+func (p *processParser) statementStart() (Pos, string, string, error) {
+	if !p.atStatement() {
+		tok := p.peek()
+		if tok == nil {
+			return p.eofPos(), "", "", errorf(p.eofPos(), "expected statement")
+		}
+		return tok.pos, "", "", errorf(tok.pos, "expected statement but found %q", tok)
+	}
+
+	var label string
+	pos := p.peek().pos
+	if !isKeyword(*p.peek()) {
+		label = p.next().text
+	}
+	keyword := strings.ToLower(p.next().text)
+	return pos, label, keyword, nil
+}
+
+func (p *processParser) parseProcess() (*Process, error) {
+	pos, label, keyword, err := p.statementStart()
+	if err != nil {
+		return nil, err
+	}
+	if keyword != "process" {
+		return nil, errorf(pos, "expected PROCESS statement but found %q", keyword)
+	}
+	if label == "" {
+		return nil, errorf(pos, "PROCESS statement requires a name")
+	}
+
+	out := &Process{
+		Pos:  pos,
+		Name: label,
+	}
+	out.Params, err = p.parseParams()
+	if err != nil {
+		return nil, err
+	}
+
+	var end string
+	out.Statements, end, err = p.parseStatements("pend")
+	if err != nil {
+		return nil, err
+	}
+	if end != "pend" {
+		return nil, errorf(p.eofPos(), "missing PEND statement")
+	}
+
+	if tok := p.peek(); tok != nil {
+		return nil, errorf(tok.pos, "unexpected %q after PEND", tok)
+	}
+	return out, nil
+}
+
+// parseStatements reads statements until one of the terminating keywords, which is consumed and returned.
+func (p *processParser) parseStatements(terminators ...string) ([]Statement, string, error) {
+	var out []Statement
+
+	for p.peek() != nil {
+		start := p.idx
+		pos, label, keyword, err := p.statementStart()
+		if err != nil {
+			return nil, "", err
+		}
+		if keyword == "pend" && !slices.Contains(terminators, keyword) {
+			// Leave PEND for the enclosing block so unterminated IF statements are reported
+			p.idx = start
+			return out, "", nil
+		}
+
+		for _, t := range terminators {
+			if keyword == t {
+				if label != "" {
+					return nil, "", errorf(pos, "%s cannot have a label", strings.ToUpper(keyword))
+				}
+				return out, keyword, nil
+			}
+		}
+
+		var stmt Statement
+		switch keyword {
+		case "copy", "submit", "symbol":
+			stmt, err = p.parseStep(pos, label, keyword)
+
+		case "run":
+			tok := p.next()
+			if tok == nil || tok.kind != tokenWord || (!strings.EqualFold(tok.text, "task") && !strings.EqualFold(tok.text, "job")) {
+				return nil, "", errorf(pos, "expected RUN TASK or RUN JOB")
+			}
+			stmt, err = p.parseStep(pos, label, "run "+strings.ToLower(tok.text))
+
+		case "if":
+			stmt, err = p.parseIf(pos, label)
+
+		case "goto":
+			tok := p.next()
+			if tok == nil || tok.kind != tokenWord || tok.first {
+				return nil, "", errorf(pos, "GOTO requires a step label")
+			}
+			stmt = &Goto{Pos: pos, Label: label, Target: tok.text}
+
+		case "exit":
+			stmt = &Exit{Pos: pos, Label: label}
+
+		default:
+			return nil, "", errorf(pos, "unexpected %s statement", strings.ToUpper(keyword))
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		out = append(out, stmt)
+
+		if tok := p.peek(); tok != nil && !p.atStatement() {
+			return nil, "", errorf(tok.pos, "unexpected %q", tok)
+		}
+	}
+
+	return out, "", nil
+}
+
+func (p *processParser) parseStep(pos Pos, label, keyword string) (*Step, error) {
+	params, err := p.parseParams()
+	if err != nil {
+		return nil, err
+	}
+	return &Step{
+		Pos:     pos,
+		Label:   label,
+		Keyword: keyword,
+		Params:  params,
+	}, nil
+}
+
+func (p *processParser) parseIf(pos Pos, label string) (*If, error) {
+	out := &If{
+		Pos:   pos,
+		Label: label,
+	}
+
+	cond, err := p.parseCondition()
+	if err != nil {
+		return nil, err
+	}
+	out.Condition = cond
+
+	tok := p.next()
+	if tok == nil || tok.first || !strings.EqualFold(tok.text, "then") {
+		return nil, errorf(pos, "expected THEN after IF condition")
+	}
+
+	var end string
+	out.Then, end, err = p.parseStatements("else", "eif")
+	if err != nil {
+		return nil, err
+	}
+	if end == "else" {
+		out.HasElse = true
+		out.Else, end, err = p.parseStatements("eif")
+		if err != nil {
+			return nil, err
+		}
+	}
+	if end != "eif" {
+		return nil, errorf(pos, "IF is missing EIF")
+	}
+	return out, nil
+}
+
+var operators = map[string]string{
+	"eq": "eq", "=": "eq", "==": "eq",
+	"ne": "ne", "<>": "ne", "!=": "ne",
+	"gt": "gt", ">": "gt",
+	"ge": "ge", ">=": "ge",
+	"lt": "lt", "<": "lt",
+	"le": "le", "<=": "le",
+}
+
+// parseCondition reads "(step01 eq 0)"
+func (p *processParser) parseCondition() (Condition, error) {
+	open := p.next()
+	if open == nil || open.kind != tokenLParen {
+		return Condition{}, errorf(p.posOf(open), "expected ( after IF")
+	}
+
+	step := p.next()
+	if step == nil || step.kind != tokenWord {
+		return Condition{}, errorf(p.posOf(step), "expected step label in IF condition")
+	}
+
+	var op string
+	for tok := p.peek(); tok != nil && tok.kind != tokenRParen; tok = p.peek() {
+		if tok.kind == tokenWord && op != "" {
+			break
+		}
+		op += strings.ToLower(p.next().text)
+	}
+	normalized, ok := operators[op]
+	if !ok {
+		return Condition{}, errorf(step.pos, "invalid operator %q in IF condition", op)
+	}
+
+	value := p.next()
+	if value == nil || value.kind != tokenWord {
+		return Condition{}, errorf(p.posOf(value), "expected value in IF condition")
+	}
+
+	closing := p.next()
+	if closing == nil || closing.kind != tokenRParen {
+		return Condition{}, errorf(p.posOf(closing), "expected ) after IF condition")
+	}
+
+	return Condition{
+		Pos:      open.pos,
+		Step:     step.text,
+		Operator: normalized,
+		Value:    value.text,
+	}, nil
+}
+
+func (p *processParser) posOf(tok *token) Pos {
+	if tok == nil {
+		return p.eofPos()
+	}
+	return tok.pos
+}
+
+// parseParams reads parameters until the next statement
+func (p *processParser) parseParams() ([]Param, error) {
+	var out []Param
+	for p.peek() != nil && !p.atStatement() {
+		param, err := p.parseParam()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, param)
+	}
+	return out, nil
+}
+
+// parseParam reads one of
+//
+//	name
+//	name=value
+//	name (list)
+//	(list)
+//	"string"
+func (p *processParser) parseParam() (Param, error) {
+	tok := p.peek()
+	switch tok.kind {
+	case tokenLParen, tokenString:
+		value, err := p.parseValue()
+		if err != nil {
+			return Param{}, err
+		}
+		return Param{Pos: tok.pos, Value: value}, nil
+
+	case tokenWord:
+		p.next()
+		out := Param{Pos: tok.pos, Name: tok.text}
+
+		next := p.peek()
+		switch {
+		case next == nil:
+		case next.kind == tokenEquals:
+			p.next()
+			if p.peek() == nil || p.atStatement() {
+				return Param{}, errorf(next.pos, "expected value after %s=", tok.text)
+			}
+			value, err := p.parseValue()
+			if err != nil {
+				return Param{}, err
+			}
+			out.Value = value
+
+		case next.kind == tokenLParen && !next.first:
+			value, err := p.parseValue()
+			if err != nil {
+				return Param{}, err
+			}
+			out.Value = value
+		}
+		return out, nil
+	}
+
+	return Param{}, errorf(tok.pos, "unexpected %q", tok)
+}
+
+func (p *processParser) parseValue() (*Value, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokenWord:
+		return &Value{Pos: tok.pos, Text: tok.text}, nil
+
+	case tokenString:
+		return &Value{Pos: tok.pos, Text: tok.text, Quote: tok.quote}, nil
+
+	case tokenLParen:
+		out := &Value{Pos: tok.pos, IsList: true}
+		for {
+			next := p.peek()
+			if next == nil {
+				return nil, errorf(tok.pos, "missing )")
+			}
+			switch next.kind {
+			case tokenRParen:
+				p.next()
+				return out, nil
+			case tokenComma:
+				p.next()
+				continue
+			}
+
+			param, err := p.parseParam()
+			if err != nil {
+				return nil, err
+			}
+			out.List = append(out.List, param)
+		}
+	}
+
+	return nil, errorf(tok.pos, "unexpected %q", tok)
+}
@@ -0,0 +1,105 @@
+package process_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/go-connect-direct/process"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("testdata", "sample.cdp"))
+	require.NoError(t, err)
+
+	proc, err := process.Parse(string(bs))
+	require.NoError(t, err)
+
+	require.Equal(t, "SAMPLE", proc.Name)
+	require.Equal(t, process.Pos{Line: 2, Column: 1}, proc.Pos)
+	require.Len(t, proc.Params, 5)
+	require.Equal(t, "fedach-dit", proc.Param("snode").Value.Text)
+	require.Equal(t, "/data/inbound/ach file.txt", proc.Param("&DEST").Value.Text)
+	require.Equal(t, '"', proc.Param("&DEST").Value.Quote)
+
+	require.Len(t, proc.Statements, 5)
+
+	copyStep, ok := proc.Statements[0].(*process.Step)
+	require.True(t, ok)
+	require.Equal(t, "STEP01", copyStep.Label)
+	require.Equal(t, "copy", copyStep.Keyword)
+	require.Equal(t, process.Pos{Line: 7, Column: 1}, copyStep.Pos)
+
+	from := copyStep.Param("from")
+	require.NotNil(t, from)
+	require.True(t, from.Value.IsList)
+	require.Equal(t, "&FILE", from.Value.Param("file").Value.Text)
+	require.NotNil(t, from.Value.Param("pnode"))
+	require.Nil(t, from.Value.Param("pnode").Value)
+
+	to := copyStep.Param("to")
+	require.Equal(t, "RPL", to.Value.Param("disp").Value.Text)
+	require.Equal(t, process.Pos{Line: 9, Column: 19}, to.Value.Param("disp").Pos)
+	require.Equal(t, "1M", copyStep.Param("ckpt").Value.Text)
+
+	ifStmt, ok := proc.Statements[1].(*process.If)
+	require.True(t, ok)
+	require.Equal(t, process.Condition{Pos: process.Pos{Line: 12, Column: 6}, Step: "STEP01", Operator: "eq", Value: "0"}, ifStmt.Condition)
+	require.True(t, ifStmt.HasElse)
+	require.Len(t, ifStmt.Then, 1)
+	require.Len(t, ifStmt.Else, 2)
+
+	run := ifStmt.Then[0].(*process.Step)
+	require.Equal(t, "STEP02", run.StatementLabel())
+	require.Equal(t, "run task", run.Keyword)
+	require.Equal(t, "", run.Params[0].Name)
+	require.Equal(t, "UNIX", run.Params[0].Value.Param("pgm").Value.Text)
+	require.Equal(t, "mv /data/outbound/ach.txt /data/archive/", run.Param("sysopts").Value.Text)
+
+	require.Equal(t, `echo "copy failed"`, ifStmt.Else[0].(*process.Step).Param("sysopts").Value.Text)
+	require.Equal(t, "FAILED", ifStmt.Else[1].(*process.Goto).Target)
+
+	submit := proc.Statements[2].(*process.Step)
+	require.Equal(t, "submit", submit.Keyword)
+	require.Equal(t, "ok", submit.Param("&STATUS").Value.Text)
+
+	require.IsType(t, &process.Exit{}, proc.Statements[3])
+	require.Equal(t, "FAILED", proc.Statements[4].StatementLabel())
+}
+
+func TestParse_Errors(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{"", "1:1: expected statement"},
+		{"copy from (file=a)\npend", `1:1: expected PROCESS statement but found "copy"`},
+		{"process snode=a\npend", "1:1: PROCESS statement requires a name"},
+		{"sample process snode=a\n", "1:23: missing PEND statement"},
+		{"sample process snode=a\nstep01 copy from (file=a\npend", "2:18: missing )"},
+		{"sample process snode=a\nstep01 copy from (file=\"a)\npend", "2:24: unterminated string"},
+		{"sample process /* snode=a\npend", "1:16: unterminated comment"},
+		{"sample process /*/ snode=a\npend", "1:16: unterminated comment"},
+		{"sample process snode=a\n  if (step01 eq 0) then\n  exit\npend", "2:3: IF is missing EIF"},
+		{"sample process snode=a\n  if (step01 0) then\n  eif\npend", `2:7: invalid operator "0" in IF condition`},
+		{"sample process snode=a\n  if (step01 eq 0)\n  eif\npend", "2:3: expected THEN after IF condition"},
+		{"sample process snode=a\n  run program x\npend", "2:3: expected RUN TASK or RUN JOB"},
+		{"sample process snode=a\n  goto\npend", "2:3: GOTO requires a step label"},
+		{"sample process snode=a\n  eif\npend", "2:3: unexpected EIF statement"},
+		{"sample process snode=a\npend\nexit", `3:1: unexpected "exit" after PEND`},
+		{"sample process snode=a\n  exit now\npend", `2:8: unexpected "now"`},
+		{"sample process snode=\npend", "1:21: expected value after snode="},
+	}
+	for _, tc := range cases {
+		t.Run(tc.expected, func(t *testing.T) {
+			_, err := process.Parse(tc.input)
+			require.Error(t, err)
+			require.Equal(t, tc.expected, err.Error())
+
+			var perr *process.Error
+			require.ErrorAs(t, err, &perr)
+		})
+	}
+}
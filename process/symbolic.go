@@ -0,0 +1,266 @@
+package process
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/moov-io/go-connect-direct/parser"
+)
+
+// Resolver substitutes symbolic variables, such as &FILE, with their values.
+//
+// Values come from the &VAR=value defaults of a PROCESS statement, overridden by the arguments
+// given when the process was submitted. Names are compared without case.
+//
+//	resolver := process.NewResolver(proc, map[string]string{"&FILE": "/data/outbound/ach.txt"})
+//	resolver.Replace("Source file open failed. Filename=&FILE.")
+//	// Source file open failed. Filename=/data/outbound/ach.txt.
+type Resolver struct {
+	values map[string]string
+}
+
+// NewResolver creates a Resolver from the defaults in proc, which can be nil, and submit arguments.
+// Argument names can be given with or without the leading ampersand.
+func NewResolver(proc *Process, args map[string]string) *Resolver {
+	r := &Resolver{
+		values: make(map[string]string),
+	}
+	if proc != nil {
+		for _, param := range proc.Params {
+			if strings.HasPrefix(param.Name, "&") && param.Value != nil && !param.Value.IsList {
+				r.values[symbolicKey(param.Name)] = param.Value.Text
+			}
+		}
+	}
+	for name, value := range args {
+		r.values[symbolicKey(name)] = value
+	}
+	return r
+}
+
+func symbolicKey(name string) string {
+	return strings.ToUpper(strings.TrimPrefix(name, "&"))
+}
+
+// Lookup returns the value of a symbolic variable, such as "&FILE".
+func (r *Resolver) Lookup(name string) (string, bool) {
+	value, found := r.values[symbolicKey(name)]
+	return value, found
+}
+
+// Replace substitutes every known symbolic variable in text. Unknown symbolics are left as they are.
+func (r *Resolver) Replace(text string) string {
+	if !strings.Contains(text, "&") {
+		return text
+	}
+
+	var buf strings.Builder
+	for {
+		start, end := nextSymbolic(text)
+		if start < 0 {
+			buf.WriteString(text)
+			return buf.String()
+		}
+		buf.WriteString(text[:start])
+		if value, found := r.Lookup(text[start:end]); found {
+			buf.WriteString(value)
+		} else {
+			buf.WriteString(text[start:end])
+		}
+		text = text[end:]
+	}
+}
+
+// Unresolved returns the symbolic variables in text which have no value, in the order they appear.
+func (r *Resolver) Unresolved(text string) []string {
+	var out []string
+	for {
+		start, end := nextSymbolic(text)
+		if start < 0 {
+			return out
+		}
+		if _, found := r.Lookup(text[start:end]); !found {
+			out = append(out, text[start:end])
+		}
+		text = text[end:]
+	}
+}
+
+// nextSymbolic returns the bounds of the next &NAME in text, or -1 when there is none.
+// Names are made of letters, digits and underscores, so "&FILE." ends before the period.
+func nextSymbolic(text string) (int, int) {
+	for idx := 0; idx < len(text); idx++ {
+		if text[idx] != '&' {
+			continue
+		}
+		end := idx + 1
+		for end < len(text) && isSymbolicChar(text[end]) {
+			end++
+		}
+		// A lone ampersand isn't a symbolic
+		if end > idx+1 {
+			return idx, end
+		}
+	}
+	return -1, -1
+}
+
+// symbolicWidth is the longest symbolic variable name, not counting the ampersand
+const symbolicWidth = 32
+
+// ValidateSymbolic checks a symbolic variable name, such as "&FILE", which is an ampersand followed by
+// one to 32 letters, digits and underscores starting with a letter.
+func ValidateSymbolic(name string) error {
+	rest, found := strings.CutPrefix(name, "&")
+	if !found {
+		return fmt.Errorf("%q must start with &", name)
+	}
+	if rest == "" || len(rest) > symbolicWidth {
+		return fmt.Errorf("%q must be 1 to %d characters after the &", name, symbolicWidth)
+	}
+	for i := 0; i < len(rest); i++ {
+		if !isSymbolicChar(rest[i]) {
+			return fmt.Errorf("%q must be letters, digits and underscores", name)
+		}
+	}
+	if c := rest[0]; !(c >= 'a' && c <= 'z') && !(c >= 'A' && c <= 'Z') {
+		return fmt.Errorf("%q must start with a letter after the &", name)
+	}
+	return nil
+}
+
+func isSymbolicChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '_'
+}
+
+// Resolve returns a copy of proc with symbolic variables substituted in every parameter value.
+// The &VAR=value defaults of the PROCESS statement are set to their resolved values.
+//
+// An error is returned listing any symbolics without a value.
+func (r *Resolver) Resolve(proc *Process) (*Process, error) {
+	out := &Process{
+		Pos:        proc.Pos,
+		Name:       proc.Name,
+		Params:     r.resolveParams(proc.Params),
+		Statements: r.resolveStatements(proc.Statements),
+	}
+	for i, param := range out.Params {
+		if !strings.HasPrefix(param.Name, "&") || param.Value == nil || param.Value.IsList {
+			continue
+		}
+		if value, found := r.Lookup(param.Name); found {
+			out.Params[i].Value = r.resolvedValue(param.Value, value)
+		}
+	}
+
+	missing := make(map[string]bool)
+	var check func(params []Param)
+	check = func(params []Param) {
+		for _, param := range params {
+			if param.Value == nil {
+				continue
+			}
+			check(param.Value.List)
+			for _, name := range r.Unresolved(param.Value.Text) {
+				missing[strings.ToUpper(name)] = true
+			}
+		}
+	}
+	check(out.Params)
+	walkStatements(out.Statements, func(stmt Statement) {
+		if s, ok := stmt.(*Step); ok {
+			check(s.Params)
+		}
+	})
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return out, fmt.Errorf("unresolved symbolics: %s", strings.Join(names, ", "))
+	}
+	return out, nil
+}
+
+func (r *Resolver) resolveStatements(stmts []Statement) []Statement {
+	out := make([]Statement, 0, len(stmts))
+	for _, stmt := range stmts {
+		switch s := stmt.(type) {
+		case *Step:
+			step := *s
+			step.Params = r.resolveParams(s.Params)
+			out = append(out, &step)
+
+		case *If:
+			block := *s
+			block.Then = r.resolveStatements(s.Then)
+			block.Else = r.resolveStatements(s.Else)
+			out = append(out, &block)
+
+		default:
+			out = append(out, stmt)
+		}
+	}
+	return out
+}
+
+func (r *Resolver) resolveParams(params []Param) []Param {
+	if params == nil {
+		return nil
+	}
+	out := make([]Param, len(params))
+	for i, param := range params {
+		out[i] = param
+		if param.Value == nil {
+			continue
+		}
+		if param.Value.IsList {
+			value := *param.Value
+			value.List = r.resolveParams(param.Value.List)
+			out[i].Value = &value
+			continue
+		}
+		out[i].Value = r.resolvedValue(param.Value, r.Replace(param.Value.Text))
+	}
+	return out
+}
+
+// resolvedValue replaces the text of a value, adding quotes when the new text needs them.
+func (r *Resolver) resolvedValue(value *Value, text string) *Value {
+	out := *value
+	out.Text = text
+	if out.Quote == 0 || strings.ContainsRune(text, out.Quote) {
+		if quoted, err := quoteValue(text); err == nil {
+			out.Quote = quoted.Quote
+		}
+	}
+	return &out
+}
+
+// ResolveSummary substitutes symbolic variables in the descriptions of summary records.
+func (r *Resolver) ResolveSummary(stats *parser.SummaryStats) {
+	for i := range stats.Stats {
+		stat := &stats.Stats[i]
+		stat.Description = r.Replace(stat.Description)
+		stat.ApplicationDescription = r.Replace(stat.ApplicationDescription)
+	}
+}
+
+// ResolveDetail substitutes symbolic variables in the message text, file names and fields of detail records,
+// such as "Short Text => Source file open failed. Filename=&FILE."
+func (r *Resolver) ResolveDetail(stats *parser.DetailStats) {
+	for i := range stats.Stats {
+		stat := &stats.Stats[i]
+		stat.MessageText = r.Replace(stat.MessageText)
+		stat.ShortText = r.Replace(stat.ShortText)
+		for key, value := range stat.Fields {
+			stat.Fields[key] = r.Replace(value)
+		}
+		if stat.Copy != nil {
+			stat.Copy.SourceFile = r.Replace(stat.Copy.SourceFile)
+			stat.Copy.DestinationFile = r.Replace(stat.Copy.DestinationFile)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package process_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/go-connect-direct/parser"
+	"github.com/moov-io/go-connect-direct/process"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("testdata", "sample.cdp"))
+	require.NoError(t, err)
+
+	proc, err := process.Parse(string(bs))
+	require.NoError(t, err)
+
+	resolver := process.NewResolver(proc, map[string]string{
+		"FILE": "/data/outbound/ach 20260203.txt",
+	})
+
+	value, found := resolver.Lookup("&file")
+	require.True(t, found)
+	require.Equal(t, "/data/outbound/ach 20260203.txt", value)
+
+	value, found = resolver.Lookup("&DEST")
+	require.True(t, found)
+	require.Equal(t, "/data/inbound/ach file.txt", value)
+
+	require.Equal(t, "Filename=/data/outbound/ach 20260203.txt.", resolver.Replace("Filename=&FILE."))
+	require.Equal(t, "a & b &MISSING", resolver.Replace("a & b &MISSING"))
+	require.Equal(t, []string{"&MISSING", "&OTHER"}, resolver.Unresolved("&FILE &MISSING &OTHER"))
+
+	resolved, err := resolver.Resolve(proc)
+	require.NoError(t, err)
+
+	require.Equal(t, `"/data/outbound/ach 20260203.txt"`, formatFile(resolved, "from"))
+	require.Equal(t, `"/data/inbound/ach file.txt"`, formatFile(resolved, "to"))
+	require.Equal(t, "/data/outbound/ach 20260203.txt", resolved.Param("&FILE").Value.Text)
+
+	// The original process is unchanged
+	require.Equal(t, "&FILE", proc.Statements[0].(*process.Step).Param("from").Value.Param("file").Value.Text)
+
+	// Resolved text parses again
+	_, err = process.Parse(process.Format(resolved))
+	require.NoError(t, err)
+}
+
+func formatFile(proc *process.Process, name string) string {
+	value := proc.Statements[0].(*process.Step).Param(name).Value.Param("file").Value
+	return string(value.Quote) + value.Text + string(value.Quote)
+}
+
+func TestResolver_Unresolved(t *testing.T) {
+	proc, err := process.Parse(`sample process snode=cdnode
+step01 copy from (file=&FILE pnode) to (file=&DIR/&NAME snode)
+pend`)
+	require.NoError(t, err)
+
+	_, err = process.NewResolver(proc, map[string]string{"&DIR": "/tmp"}).Resolve(proc)
+	require.EqualError(t, err, "unresolved symbolics: &FILE, &NAME")
+}
+
+func TestResolver_Stats(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("..", "parser", "testdata", "pnumber13_stats.txt"))
+	require.NoError(t, err)
+
+	detail, err := parser.ParseDetail(string(bs))
+	require.NoError(t, err)
+
+	resolver := process.NewResolver(nil, map[string]string{"&FILE": "/data/outbound/ach.txt"})
+	resolver.ResolveDetail(&detail)
+
+	var found int
+	for _, stat := range detail.Stats {
+		require.NotContains(t, stat.ShortText, "&FILE")
+		if stat.ShortText == "Source file open failed. Filename=/data/outbound/ach.txt." {
+			require.Equal(t, stat.ShortText, stat.Fields["Short Text"])
+			found++
+		}
+	}
+	require.Equal(t, 2, found)
+
+	summary := parser.SummaryStats{
+		Stats: []parser.SummaryStat{{Type: "P", Description: "Source file open failed. Filename=&FILE."}},
+	}
+	resolver.ResolveSummary(&summary)
+	require.Equal(t, "Source file open failed. Filename=/data/outbound/ach.txt.", summary.Stats[0].Description)
+}
+
+func TestValidateSymbolic(t *testing.T) {
+	require.NoError(t, process.ValidateSymbolic("&FILE"))
+	require.NoError(t, process.ValidateSymbolic("&DESTINATION_FILE_2"))
+	require.NoError(t, process.ValidateSymbolic("&ABCDEFGHIJKLMNOPQRSTUVWXYZ012345"))
+
+	require.EqualError(t, process.ValidateSymbolic("FILE"), `"FILE" must start with &`)
+	require.EqualError(t, process.ValidateSymbolic("&"), `"&" must be 1 to 32 characters after the &`)
+	require.EqualError(t, process.ValidateSymbolic("&ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456"), `"&ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456" must be 1 to 32 characters after the &`)
+	require.EqualError(t, process.ValidateSymbolic("&BAD NAME"), `"&BAD NAME" must be letters, digits and underscores`)
+	require.EqualError(t, process.ValidateSymbolic("&1ST"), `"&1ST" must start with a letter after the &`)
+
+	// Symbolics aren't limited to eight characters like labels
+	_, err := process.New("sample").Symbolic("&DESTINATION_FILE_2", "x").Build()
+	require.NoError(t, err)
+}
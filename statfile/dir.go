@@ -0,0 +1,130 @@
+package statfile
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// File is a statistics file found in a directory.
+type File struct {
+	Path string
+
+	// Date is the day the file was started and Sequence is its number within the day
+	Date     time.Time
+	Sequence int
+
+	// Compressed is true for gzip copies, such as S20260203.001.gz
+	Compressed bool
+}
+
+var fileNameRegex = regexp.MustCompile(`^S(\d{8})\.(\d{3})(\.gz)?$`)
+
+// ParseFileName reads the date and sequence from a statistics file name, such as S20260203.001.
+// Names ending in .gz are also accepted, for compressed copies.
+func ParseFileName(name string) (File, bool) {
+	match := fileNameRegex.FindStringSubmatch(filepath.Base(name))
+	if match == nil {
+		return File{}, false
+	}
+	date, err := time.Parse("20060102", match[1])
+	if err != nil {
+		return File{}, false
+	}
+	seq, _ := strconv.Atoi(match[2])
+
+	return File{
+		Path:       name,
+		Date:       date,
+		Sequence:   seq,
+		Compressed: match[3] != "",
+	}, true
+}
+
+// Files returns the statistics files in dir ordered by date and sequence. Other files are ignored.
+func Files(dir string) ([]File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading statistics directory: %w", err)
+	}
+
+	var out []File
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if file, found := ParseFileName(filepath.Join(dir, entry.Name())); found {
+			out = append(out, file)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if !out[i].Date.Equal(out[j].Date) {
+			return out[i].Date.Before(out[j].Date)
+		}
+		return out[i].Sequence < out[j].Sequence
+	})
+	return out, nil
+}
+
+// Open returns a Reader for a statistics file, decompressing files ending in .gz.
+// The returned io.Closer closes the file.
+func Open(path string) (*Reader, io.Closer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var r io.Reader = f
+	if filepath.Ext(path) == ".gz" {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			f.Close()
+			return nil, nil, fmt.Errorf("%s: %w", path, err)
+		}
+		r = gz
+	}
+
+	reader := NewReader(r)
+	reader.file = path
+	return reader, f, nil
+}
+
+// ReadFile reads every record from a statistics file.
+func ReadFile(path string) (Records, error) {
+	reader, closer, err := Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	var out Records
+	for reader.Scan() {
+		out = append(out, reader.Record())
+	}
+	return out, reader.Err()
+}
+
+// ReadDir reads every record from the statistics files in dir, such as work/cdnode/ or a directory
+// of copied files, in date order.
+func ReadDir(dir string) (Records, error) {
+	files, err := Files(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var out Records
+	for _, file := range files {
+		records, err := ReadFile(file.Path)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, records...)
+	}
+	return out, nil
+}
@@ -0,0 +1,70 @@
+package statfile_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/moov-io/go-connect-direct/statfile"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFileName(t *testing.T) {
+	file, found := statfile.ParseFileName("/opt/cdunix/ndm/work/cdnode/S20260203.012")
+	require.True(t, found)
+	require.Equal(t, time.Date(2026, time.February, 3, 0, 0, 0, 0, time.UTC), file.Date)
+	require.Equal(t, 12, file.Sequence)
+	require.False(t, file.Compressed)
+
+	file, found = statfile.ParseFileName("S20260203.001.gz")
+	require.True(t, found)
+	require.True(t, file.Compressed)
+
+	for _, name := range []string{"S2026020.001", "S20260231.001", "T20260203.001", "S20260203.001.bak"} {
+		_, found := statfile.ParseFileName(name)
+		require.False(t, found, name)
+	}
+}
+
+func TestReadDir(t *testing.T) {
+	dir := filepath.Join("testdata", "cdnode")
+
+	files, err := statfile.Files(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	require.Equal(t, filepath.Join(dir, "S20260202.001"), files[0].Path)
+	require.Equal(t, filepath.Join(dir, "S20260203.002.gz"), files[2].Path)
+
+	records, err := statfile.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, records, 6)
+	require.Equal(t, filepath.Join(dir, "S20260203.001"), records[3].File)
+	require.Equal(t, 4, records[3].Line)
+
+	summary, err := records.Summary()
+	require.NoError(t, err)
+
+	var ids []string
+	for _, stat := range summary.Stats {
+		ids = append(ids, stat.ID.ID)
+	}
+	require.Equal(t, []string{"SSTR", "SUBP", "PSTR", "CTRC", "PRED", "EXFA"}, ids)
+	require.Equal(t, "Connect:Direct server started.", summary.Stats[0].Description)
+	require.Equal(t, "sample", summary.Stats[2].Description)
+	require.Empty(t, summary.Stats[5].Description)
+
+	procs := summary.Processes()
+	require.Len(t, procs, 1)
+	require.True(t, procs[0].HasEnded())
+	require.Equal(t, 8, procs[0].Code)
+
+	external := summary.Stats[5]
+	require.Equal(t, "X", external.Type)
+	require.Equal(t, "File Agent", external.ApplicationDescription)
+	require.Equal(t, "cdadmin", external.UserID)
+	require.Equal(t, "cdnode", external.NodeName)
+
+	_, err = statfile.ReadDir(filepath.Join("testdata", "missing"))
+	require.Error(t, err)
+}
@@ -0,0 +1,72 @@
+package statfile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Offset is how far a Tailer has read, saved after each record so it can resume after a restart.
+type Offset struct {
+	// File is the base name of the statistics file, such as S20260203.001
+	File string `json:"file"`
+
+	// Position is the byte offset after the last record delivered and Line is its line number
+	Position int64 `json:"position"`
+	Line     int   `json:"line"`
+}
+
+// OffsetStore persists the Offset of a Tailer.
+type OffsetStore interface {
+	// Load returns the saved offset, or a zero Offset when nothing has been saved
+	Load() (Offset, error)
+	Save(offset Offset) error
+}
+
+// FileOffsetStore keeps the offset as JSON in a file, which is replaced atomically on each save.
+type FileOffsetStore struct {
+	Path string
+}
+
+func (s *FileOffsetStore) Load() (Offset, error) {
+	var out Offset
+
+	bs, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return out, nil
+	}
+	if err != nil {
+		return out, fmt.Errorf("loading offset: %w", err)
+	}
+	if err := json.Unmarshal(bs, &out); err != nil {
+		return out, fmt.Errorf("loading offset: %w", err)
+	}
+	return out, nil
+}
+
+func (s *FileOffsetStore) Save(offset Offset) error {
+	bs, err := json.Marshal(offset)
+	if err != nil {
+		return fmt.Errorf("saving offset: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".*")
+	if err != nil {
+		return fmt.Errorf("saving offset: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(bs); err != nil {
+		tmp.Close()
+		return fmt.Errorf("saving offset: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("saving offset: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.Path); err != nil {
+		return fmt.Errorf("saving offset: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,352 @@
+// Package statfile reads the statistics files Connect:Direct for UNIX writes under work/<node>/.
+//
+// A new file is started each day, named SYYYYMMDD.NNN such as S20260203.001, with one record per line.
+// Each record is made up of KEY=value fields separated by a vertical bar:
+//
+//	RECI=PSTR|RECC=CAPR|DATE=02/03/2026|TIME=23:26:37.871|PNAM=sample|PNUM=13|CCOD=0|MSGI=XSMG200I|MSST=Process started.
+//
+// Records are converted into the same SummaryStat and DetailStat types returned by the parser package
+// for CLI output, so statistics can be read without a running server, including from copies of the files.
+package statfile
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/go-connect-direct/parser"
+)
+
+// Field keys found in statistics records
+const (
+	KeyRecordID      = "RECI"
+	KeyCategory      = "RECC"
+	KeyDate          = "DATE"
+	KeyTime          = "TIME"
+	KeyProcessName   = "PNAM"
+	KeyProcessNumber = "PNUM"
+	KeyStepName      = "STEP"
+	KeyCode          = "CCOD"
+	KeyFeedbackCode  = "FDBK"
+	KeyMessageID     = "MSGI"
+	KeyShortText     = "MSST"
+	KeySNode         = "SNOD"
+	KeySubmitterID   = "SBID"
+	KeySourceFile    = "SFIL"
+	KeyDestFile      = "DFIL"
+	KeyApplication   = "APPL"
+	KeyUserID        = "USID"
+	KeyNodeName      = "NODE"
+)
+
+// Record categories (RECC)
+const (
+	categoryProcess  = "CAPR"
+	categoryEvent    = "CAEV"
+	categoryExternal = "CAEX"
+)
+
+// Record is one line of a statistics file.
+type Record struct {
+	// File and Line are where the record was read, File is empty for records read with NewReader
+	File string
+	Line int
+
+	// Fields contains every KEY=value pair of the record
+	Fields map[string]string
+}
+
+// Reader reads records from a statistics file one at a time.
+//
+//	reader := statfile.NewReader(f)
+//	for reader.Scan() {
+//		rec := reader.Record()
+//	}
+//	if err := reader.Err(); err != nil {
+//		// handle error
+//	}
+type Reader struct {
+	lines *bufio.Scanner
+	file  string
+	line  int
+
+	rec Record
+	err error
+}
+
+// NewReader returns a Reader for the records in r.
+func NewReader(r io.Reader) *Reader {
+	lines := bufio.NewScanner(r)
+	lines.Buffer(make([]byte, 0, 4096), 1024*1024)
+
+	return &Reader{
+		lines: lines,
+	}
+}
+
+// Scan advances to the next record, which is available from Record. It returns false when
+// the input is exhausted or an error occurs.
+func (r *Reader) Scan() bool {
+	if r.err != nil {
+		return false
+	}
+
+	for r.lines.Scan() {
+		r.line++
+
+		line := strings.TrimSpace(r.lines.Text())
+		if line == "" {
+			continue
+		}
+
+		fields, err := parseFields(line)
+		if err != nil {
+			r.err = r.errorf("%v", err)
+			return false
+		}
+		r.rec = Record{
+			File:   r.file,
+			Line:   r.line,
+			Fields: fields,
+		}
+		return true
+	}
+
+	if err := r.lines.Err(); err != nil {
+		r.err = r.errorf("%v", err)
+	}
+	return false
+}
+
+func (r *Reader) errorf(format string, args ...interface{}) error {
+	msg := fmt.Sprintf(format, args...)
+	if r.file != "" {
+		return fmt.Errorf("%s line %d: %s", r.file, r.line, msg)
+	}
+	return fmt.Errorf("line %d: %s", r.line, msg)
+}
+
+// Record returns the most recent record found by Scan.
+func (r *Reader) Record() Record {
+	return r.rec
+}
+
+// Err returns the first error encountered by the Reader.
+func (r *Reader) Err() error {
+	return r.err
+}
+
+// All returns an iterator over the remaining records. Iteration stops after the first error is yielded.
+func (r *Reader) All() iter.Seq2[Record, error] {
+	return func(yield func(Record, error) bool) {
+		for r.Scan() {
+			if !yield(r.Record(), nil) {
+				return
+			}
+		}
+		if err := r.Err(); err != nil {
+			yield(Record{}, err)
+		}
+	}
+}
+
+// parseFields reads "KEY=value|KEY=value". A trailing bar is allowed.
+func parseFields(line string) (map[string]string, error) {
+	out := make(map[string]string)
+	for _, pair := range strings.Split(strings.TrimSuffix(line, "|"), "|") {
+		key, value, found := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" {
+			return nil, fmt.Errorf("expected KEY=value, found %q", pair)
+		}
+		if _, exists := out[key]; !exists {
+			out[key] = value
+		}
+	}
+	if out[KeyRecordID] == "" {
+		return nil, fmt.Errorf("missing %s", KeyRecordID)
+	}
+	return out, nil
+}
+
+// ID returns the record ID, such as CTRC. Unknown IDs only have their code set.
+func (rec Record) ID() parser.RecordID {
+	code := strings.ToUpper(rec.Fields[KeyRecordID])
+	if id := parser.LookupRecordID(code); id != nil {
+		return *id
+	}
+	return parser.RecordID{ID: code}
+}
+
+// Type returns P, E or X from the record category. Records without a category are process records
+// when they have a process number, otherwise the category of the record ID is used.
+func (rec Record) Type() string {
+	switch strings.ToUpper(rec.Fields[KeyCategory]) {
+	case categoryProcess:
+		return "P"
+	case categoryEvent:
+		return "E"
+	case categoryExternal:
+		return "X"
+	}
+	if rec.Fields[KeyProcessNumber] != "" {
+		return "P"
+	}
+	switch rec.ID().Category {
+	case parser.CategoryProcess:
+		return "P"
+	case parser.CategoryExternalSource:
+		return "X"
+	}
+	return "E"
+}
+
+// Date returns when the record was logged. Dates are read as MM/DD/YYYY or YYYYMMDD.
+func (rec Record) Date() (time.Time, error) {
+	date, clock := rec.Fields[KeyDate], rec.Fields[KeyTime]
+	if date == "" && clock == "" {
+		return time.Time{}, nil
+	}
+
+	layout := "01/02/2006"
+	if !strings.Contains(date, "/") {
+		layout = "20060102"
+	}
+	if clock != "" {
+		layout += " 15:04:05"
+	}
+	return time.Parse(layout, strings.TrimSpace(date+" "+clock))
+}
+
+// Summary converts the record into a SummaryStat, as "select statistics" would show it.
+func (rec Record) Summary() (parser.SummaryStat, error) {
+	date, err := rec.Date()
+	if err != nil {
+		return parser.SummaryStat{}, rec.wrap("date", err)
+	}
+	code, err := rec.code(KeyCode)
+	if err != nil {
+		return parser.SummaryStat{}, err
+	}
+	feedback, err := rec.code(KeyFeedbackCode)
+	if err != nil {
+		return parser.SummaryStat{}, err
+	}
+
+	// Summary output shows the process name on P records and the message text on E records
+	typ := rec.Type()
+	var description string
+	switch typ {
+	case "P":
+		description = rec.Fields[KeyProcessName]
+	case "E":
+		description = rec.Fields[KeyShortText]
+	}
+
+	return parser.SummaryStat{
+		Type:                   typ,
+		ID:                     rec.ID(),
+		Date:                   date,
+		Description:            description,
+		ProcessNumber:          rec.Fields[KeyProcessNumber],
+		StepName:               rec.Fields[KeyStepName],
+		Code:                   code,
+		FeedbackCode:           feedback,
+		MessageID:              rec.Fields[KeyMessageID],
+		ApplicationDescription: rec.Fields[KeyApplication],
+		UserID:                 rec.Fields[KeyUserID],
+		NodeName:               rec.Fields[KeyNodeName],
+	}, nil
+}
+
+// Detail converts the record into a DetailStat, as "select statistics ... detail" would show it.
+// Fields are keyed by the statistics file keys, such as PNAM, rather than the CLI labels.
+func (rec Record) Detail() (parser.DetailStat, error) {
+	date, err := rec.Date()
+	if err != nil {
+		return parser.DetailStat{}, rec.wrap("date", err)
+	}
+	code, err := rec.code(KeyCode)
+	if err != nil {
+		return parser.DetailStat{}, err
+	}
+	feedback, err := rec.code(KeyFeedbackCode)
+	if err != nil {
+		return parser.DetailStat{}, err
+	}
+
+	out := parser.DetailStat{
+		Type:          rec.Type(),
+		ID:            rec.ID(),
+		Date:          date,
+		ProcessName:   rec.Fields[KeyProcessName],
+		ProcessNumber: rec.Fields[KeyProcessNumber],
+		SubmitterID:   rec.Fields[KeySubmitterID],
+		StepName:      rec.Fields[KeyStepName],
+		SNode:         rec.Fields[KeySNode],
+		Code:          code,
+		FeedbackCode:  feedback,
+		MessageID:     rec.Fields[KeyMessageID],
+		ShortText:     rec.Fields[KeyShortText],
+		Fields:        rec.Fields,
+	}
+	if out.ID.ID == parser.CopyTerminationRecord.ID {
+		out.Copy = &parser.CopyTermination{
+			SourceFile:      rec.Fields[KeySourceFile],
+			DestinationFile: rec.Fields[KeyDestFile],
+		}
+	}
+	return out, nil
+}
+
+func (rec Record) code(key string) (int, error) {
+	value := strings.TrimSpace(rec.Fields[key])
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, rec.wrap(key, fmt.Errorf("invalid number %q", value))
+	}
+	return n, nil
+}
+
+func (rec Record) wrap(field string, err error) error {
+	if rec.File != "" {
+		return fmt.Errorf("%s line %d: parsing %s: %v", rec.File, rec.Line, field, err)
+	}
+	return fmt.Errorf("line %d: parsing %s: %v", rec.Line, field, err)
+}
+
+// Records are read from one or more statistics files.
+type Records []Record
+
+// Summary converts every record into SummaryStats.
+func (rs Records) Summary() (parser.SummaryStats, error) {
+	var out parser.SummaryStats
+	for _, rec := range rs {
+		stat, err := rec.Summary()
+		if err != nil {
+			return out, err
+		}
+		out.Stats = append(out.Stats, stat)
+	}
+	return out, nil
+}
+
+// Detail converts every record into DetailStats.
+func (rs Records) Detail() (parser.DetailStats, error) {
+	var out parser.DetailStats
+	for _, rec := range rs {
+		stat, err := rec.Detail()
+		if err != nil {
+			return out, err
+		}
+		out.Stats = append(out.Stats, stat)
+	}
+	return out, nil
+}
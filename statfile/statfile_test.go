@@ -0,0 +1,97 @@
+package statfile_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moov-io/go-connect-direct/parser"
+	"github.com/moov-io/go-connect-direct/statfile"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReader(t *testing.T) {
+	input := `RECI=PSTR|RECC=CAPR|DATE=02/03/2026|TIME=23:26:37.871|PNAM=sample|PNUM=13|CCOD=0|MSGI=XSMG200I|MSST=Process started.|
+
+RECI=CTRC|DATE=20260203|TIME=23:26:40|PNAM=sample|PNUM=13|STEP=step01|CCOD=8|FDBK=12|MSGI=XCPS002I|SFIL=/tmp/a|DFIL=/tmp/b
+`
+	reader := statfile.NewReader(strings.NewReader(input))
+
+	var records statfile.Records
+	for rec, err := range reader.All() {
+		require.NoError(t, err)
+		records = append(records, rec)
+	}
+	require.Len(t, records, 2)
+	require.Equal(t, 3, records[1].Line)
+
+	summary, err := records.Summary()
+	require.NoError(t, err)
+	require.Equal(t, parser.SummaryStat{
+		Type:          "P",
+		ID:            parser.ProcessStarted,
+		Date:          time.Date(2026, time.February, 3, 23, 26, 37, 871000000, time.UTC),
+		Description:   "sample",
+		ProcessNumber: "13",
+		MessageID:     "XSMG200I",
+	}, summary.Stats[0])
+	require.Equal(t, 12, summary.Stats[1].FeedbackCode)
+	require.Equal(t, "step01", summary.Stats[1].StepName)
+
+	detail, err := records.Detail()
+	require.NoError(t, err)
+
+	copyStat := detail.Stats[1]
+	require.Equal(t, "P", copyStat.Type)
+	require.Equal(t, parser.CopyTerminationRecord, copyStat.ID)
+	require.Equal(t, time.Date(2026, time.February, 3, 23, 26, 40, 0, time.UTC), copyStat.Date)
+	require.Equal(t, "sample", copyStat.ProcessName)
+	require.Equal(t, "step01", copyStat.StepName)
+	require.Equal(t, 8, copyStat.Code)
+	require.Equal(t, 12, copyStat.FeedbackCode)
+	require.Equal(t, "/tmp/a", copyStat.Copy.SourceFile)
+	require.Equal(t, "/tmp/b", copyStat.Copy.DestinationFile)
+	require.Equal(t, "XCPS002I", copyStat.Fields["MSGI"])
+}
+
+func TestReader_Errors(t *testing.T) {
+	reader := statfile.NewReader(strings.NewReader("RECI=PSTR|CCOD=0\nRECI=PSTR|garbage\n"))
+	require.True(t, reader.Scan())
+	require.False(t, reader.Scan())
+	require.EqualError(t, reader.Err(), `line 2: expected KEY=value, found "garbage"`)
+
+	reader = statfile.NewReader(strings.NewReader("PNAM=sample|CCOD=0\n"))
+	require.False(t, reader.Scan())
+	require.EqualError(t, reader.Err(), "line 1: missing RECI")
+
+	reader = statfile.NewReader(strings.NewReader("RECI=PSTR|CCOD=eight\nRECI=PSTR|DATE=02/31/2026\n"))
+	var records statfile.Records
+	for reader.Scan() {
+		records = append(records, reader.Record())
+	}
+	require.NoError(t, reader.Err())
+
+	_, err := records[0].Summary()
+	require.EqualError(t, err, `line 1: parsing CCOD: invalid number "eight"`)
+
+	_, err = records[1].Detail()
+	require.EqualError(t, err, `line 2: parsing date: parsing time "02/31/2026": day out of range`)
+}
+
+func TestRecord_Type(t *testing.T) {
+	cases := map[string]string{
+		"RECI=PSTR|PNUM=13":   "P",
+		"RECI=CTRC":           "P",
+		"RECI=SSTR":           "E",
+		"RECI=SSTR|RECC=CAPR": "P",
+		"RECI=XXXX|RECC=CAEX": "X",
+		"RECI=EXFA":           "X",
+		"RECI=XXXX":           "E",
+	}
+	for line, expected := range cases {
+		reader := statfile.NewReader(strings.NewReader(line))
+		require.True(t, reader.Scan())
+		require.Equal(t, expected, reader.Record().Type(), line)
+	}
+}
@@ -0,0 +1,326 @@
+package statfile
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+type TailOptions struct {
+	// Dir is the statistics directory to follow, such as /opt/cdunix/ndm/work/cdnode
+	Dir string
+
+	// PollInterval is how often the directory is checked for new records. Defaults to one second.
+	PollInterval time.Duration
+
+	// Offsets saves progress after each record. Without a store, or before anything is saved,
+	// the Tailer starts at the end of the newest file.
+	Offsets OffsetStore
+
+	// FromStart reads every file in Dir when there is no saved offset, instead of only new records
+	FromStart bool
+}
+
+const defaultTailInterval = time.Second
+
+// Tailer follows the statistics files in a directory, delivering records as Connect:Direct writes them.
+//
+// Records are delivered one at a time and the next is not read until the previous one is accepted,
+// so a slow consumer holds the Tailer back rather than records being buffered. The offset is saved
+// after each record is delivered, which means a record can be delivered again after a crash.
+//
+// When a newer file appears, such as S20260204.001 after midnight, the current file is read to the
+// end and the Tailer moves on to the new one. A partial line at the end of the current file is waited
+// for while the file is still growing, then delivered as the file's last record. Compressed files are skipped.
+type Tailer struct {
+	opts    TailOptions
+	offset  Offset
+	started bool
+	err     error
+
+	// partialSize is the size of the current file when a newer file was found after a partial line
+	partialSize int64
+}
+
+func NewTailer(opts TailOptions) (*Tailer, error) {
+	if opts.Dir == "" {
+		return nil, errors.New("missing statistics directory")
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaultTailInterval
+	}
+	return &Tailer{
+		opts: opts,
+	}, nil
+}
+
+// Offset returns the position after the last record delivered.
+func (t *Tailer) Offset() Offset {
+	return t.offset
+}
+
+// Run follows the directory until ctx is canceled or fn returns an error, calling fn for each record.
+// The error from fn, or ctx.Err(), is returned.
+func (t *Tailer) Run(ctx context.Context, fn func(Record) error) error {
+	if err := t.start(); err != nil {
+		return err
+	}
+
+	for {
+		delivered, err := t.readCurrent(ctx, false, fn)
+		if err != nil {
+			return err
+		}
+		if delivered {
+			continue
+		}
+
+		// Move on to a newer file once the current one has been read to the end
+		next, err := t.nextFile()
+		if err != nil {
+			return err
+		}
+		if next != "" {
+			// Records can be written to the current file just before the next one is created
+			delivered, err := t.readCurrent(ctx, false, fn)
+			if err != nil {
+				return err
+			}
+			if delivered {
+				continue
+			}
+
+			// Wait for a partial last line to be finished. Once the file stops growing it won't be,
+			// so the line is delivered as it is before moving on.
+			size, err := t.currentSize()
+			if err != nil {
+				return err
+			}
+			partial := size > t.offset.Position
+			if !partial || size == t.partialSize {
+				if partial {
+					if _, err := t.readCurrent(ctx, true, fn); err != nil {
+						return err
+					}
+				}
+				t.offset = Offset{File: next}
+				t.partialSize = 0
+				continue
+			}
+			t.partialSize = size
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(t.opts.PollInterval):
+		}
+	}
+}
+
+// Records follows the directory on a background goroutine, sending each record on the returned channel.
+// The starting position is found before Records returns, so records written afterwards are delivered.
+//
+// The channel is unbuffered and is closed when ctx is canceled or an error occurs, after which Err
+// returns the reason.
+func (t *Tailer) Records(ctx context.Context) <-chan Record {
+	out := make(chan Record)
+	if err := t.start(); err != nil {
+		t.err = err
+		close(out)
+		return out
+	}
+	go func() {
+		defer close(out)
+		t.err = t.Run(ctx, func(rec Record) error {
+			select {
+			case out <- rec:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+	return out
+}
+
+// Err returns why the channel from Records was closed. It should only be called after the channel is closed.
+func (t *Tailer) Err() error {
+	return t.err
+}
+
+// start loads the saved offset, or picks the first file to read
+func (t *Tailer) start() error {
+	if t.started {
+		return nil
+	}
+	t.started = true
+
+	if t.opts.Offsets != nil {
+		offset, err := t.opts.Offsets.Load()
+		if err != nil {
+			return err
+		}
+		if offset.File != "" {
+			t.offset = offset
+			return nil
+		}
+	}
+
+	files, err := t.files()
+	if err != nil || len(files) == 0 {
+		return err
+	}
+	if t.opts.FromStart {
+		t.offset = Offset{File: filepath.Base(files[0].Path)}
+		return nil
+	}
+
+	// Skip the existing records in the newest file
+	newest := files[len(files)-1]
+	f, err := os.Open(newest.Path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	t.offset = Offset{File: filepath.Base(newest.Path)}
+	_, err = t.scanLines(f, false, func(string) error { return nil })
+	return err
+}
+
+func (t *Tailer) files() ([]File, error) {
+	files, err := Files(t.opts.Dir)
+	if err != nil {
+		return nil, err
+	}
+	out := files[:0]
+	for _, file := range files {
+		if !file.Compressed {
+			out = append(out, file)
+		}
+	}
+	return out, nil
+}
+
+// nextFile returns the name of the file after the current one, or an empty string when there isn't one
+func (t *Tailer) nextFile() (string, error) {
+	files, err := t.files()
+	if err != nil {
+		return "", err
+	}
+	if len(files) == 0 {
+		return "", nil
+	}
+	if t.offset.File == "" {
+		return filepath.Base(files[0].Path), nil
+	}
+
+	current, found := ParseFileName(t.offset.File)
+	for _, file := range files {
+		if !found || file.Date.After(current.Date) || (file.Date.Equal(current.Date) && file.Sequence > current.Sequence) {
+			return filepath.Base(file.Path), nil
+		}
+	}
+	return "", nil
+}
+
+// currentSize returns the size of the current file, or zero when it doesn't exist
+func (t *Tailer) currentSize() (int64, error) {
+	info, err := os.Stat(filepath.Join(t.opts.Dir, t.offset.File))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// readCurrent delivers the complete lines written to the current file since the last read and
+// returns true if any records were delivered. A partial last line is also delivered when final is true.
+func (t *Tailer) readCurrent(ctx context.Context, final bool, fn func(Record) error) (bool, error) {
+	if t.offset.File == "" {
+		return false, nil
+	}
+
+	path := filepath.Join(t.opts.Dir, t.offset.File)
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		// The file was removed, such as by cleanup of old statistics, so continue with the next one
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return false, err
+	}
+	if info.Size() < t.offset.Position {
+		// The file was truncated or replaced, start again from the beginning
+		t.offset.Position, t.offset.Line = 0, 0
+	}
+
+	return t.scanLines(f, final, func(line string) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		fields, err := parseFields(line)
+		if err != nil {
+			return fmt.Errorf("%s line %d: %v", path, t.offset.Line, err)
+		}
+		if err := fn(Record{File: path, Line: t.offset.Line, Fields: fields}); err != nil {
+			return err
+		}
+		if t.opts.Offsets != nil {
+			return t.opts.Offsets.Save(t.offset)
+		}
+		return nil
+	})
+}
+
+// scanLines reads complete lines from the current offset, advancing it before calling fn for each
+// non-blank line. A partial line at the end of the file is left until it has been written, unless
+// final is true, and the offset isn't advanced past a line when fn returns an error.
+func (t *Tailer) scanLines(f *os.File, final bool, fn func(string) error) (bool, error) {
+	if _, err := f.Seek(t.offset.Position, io.SeekStart); err != nil {
+		return false, err
+	}
+
+	var found bool
+	r := bufio.NewReader(f)
+	for {
+		line, err := r.ReadString('\n')
+		if errors.Is(err, io.EOF) && (!final || line == "") {
+			return found, nil
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			return found, err
+		}
+
+		prev := t.offset
+		t.offset.Position += int64(len(line))
+		t.offset.Line++
+
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if err := fn(line); err != nil {
+			// The record wasn't delivered, so it's read again next time
+			t.offset = prev
+			return found, err
+		}
+		found = true
+	}
+}
@@ -0,0 +1,222 @@
+package statfile_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/moov-io/go-connect-direct/statfile"
+
+	"github.com/stretchr/testify/require"
+)
+
+func appendLines(t *testing.T, path string, lines ...string) {
+	t.Helper()
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	require.NoError(t, err)
+	defer f.Close()
+
+	for _, line := range lines {
+		_, err := f.WriteString(line)
+		require.NoError(t, err)
+	}
+}
+
+func record(pnumber int) string {
+	return fmt.Sprintf("RECI=PSTR|RECC=CAPR|DATE=02/03/2026|TIME=23:26:37|PNAM=sample|PNUM=%d|CCOD=0|\n", pnumber)
+}
+
+func receive(t *testing.T, records <-chan statfile.Record) statfile.Record {
+	t.Helper()
+
+	select {
+	case rec, ok := <-records:
+		require.True(t, ok, "channel closed")
+		return rec
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for record")
+	}
+	return statfile.Record{}
+}
+
+func TestTailer(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "S20260203.001")
+	appendLines(t, first, record(1))
+
+	tailer, err := statfile.NewTailer(statfile.TailOptions{
+		Dir:          dir,
+		PollInterval: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	records := tailer.Records(ctx)
+
+	// Existing records are skipped, new ones are delivered, partial lines wait to be finished
+	appendLines(t, first, record(2), "RECI=PSTR|PNUM=")
+	rec := receive(t, records)
+	require.Equal(t, "2", rec.Fields["PNUM"])
+	require.Equal(t, first, rec.File)
+	require.Equal(t, 2, rec.Line)
+
+	appendLines(t, first, "3|\n")
+	rec = receive(t, records)
+	require.Equal(t, "3", rec.Fields["PNUM"])
+	require.Equal(t, 3, rec.Line)
+
+	// Daily rollover
+	second := filepath.Join(dir, "S20260204.001")
+	appendLines(t, second, record(4))
+	rec = receive(t, records)
+	require.Equal(t, second, rec.File)
+	require.Equal(t, 1, rec.Line)
+
+	cancel()
+	for range records {
+	}
+	require.ErrorIs(t, tailer.Err(), context.Canceled)
+}
+
+func TestTailer_RolloverPartialLine(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "S20260203.001")
+	appendLines(t, first, record(1))
+
+	tailer, err := statfile.NewTailer(statfile.TailOptions{
+		Dir:          dir,
+		PollInterval: 200 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	records := tailer.Records(ctx)
+
+	// The last line of the current file is finished after the next file is created
+	appendLines(t, first, "RECI=PSTR|PNUM=")
+	appendLines(t, filepath.Join(dir, "S20260204.001"), record(3))
+	time.Sleep(20 * time.Millisecond)
+	appendLines(t, first, "2|\n")
+
+	rec := receive(t, records)
+	require.Equal(t, "2", rec.Fields["PNUM"])
+	require.Equal(t, first, rec.File)
+
+	rec = receive(t, records)
+	require.Equal(t, "3", rec.Fields["PNUM"])
+
+	// A partial line which stops growing is delivered as it is before moving on
+	appendLines(t, filepath.Join(dir, "S20260204.001"), "RECI=PSTR|PNUM=")
+	appendLines(t, filepath.Join(dir, "S20260205.001"), record(4))
+
+	rec = receive(t, records)
+	require.Equal(t, "PSTR", rec.Fields["RECI"])
+	require.Empty(t, rec.Fields["PNUM"])
+	require.Equal(t, filepath.Join(dir, "S20260204.001"), rec.File)
+	require.Equal(t, 2, rec.Line)
+
+	rec = receive(t, records)
+	require.Equal(t, "4", rec.Fields["PNUM"])
+	require.Equal(t, filepath.Join(dir, "S20260205.001"), rec.File)
+}
+
+func TestTailer_Resume(t *testing.T) {
+	dir := t.TempDir()
+	appendLines(t, filepath.Join(dir, "S20260203.001"), record(1), record(2))
+	appendLines(t, filepath.Join(dir, "S20260203.002"), record(3))
+
+	store := &statfile.FileOffsetStore{Path: filepath.Join(t.TempDir(), "offset.json")}
+	opts := statfile.TailOptions{
+		Dir:          dir,
+		PollInterval: 10 * time.Millisecond,
+		Offsets:      store,
+		FromStart:    true,
+	}
+
+	// Stop after the second record, as if the process was restarted
+	stop := errors.New("stop")
+	var seen []string
+	tailer, err := statfile.NewTailer(opts)
+	require.NoError(t, err)
+	err = tailer.Run(context.Background(), func(rec statfile.Record) error {
+		seen = append(seen, rec.Fields["PNUM"])
+		if len(seen) == 2 {
+			return stop
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, stop)
+	require.Equal(t, []string{"1", "2"}, seen)
+
+	offset, err := store.Load()
+	require.NoError(t, err)
+	require.Equal(t, statfile.Offset{File: "S20260203.001", Position: int64(len(record(1))), Line: 1}, offset)
+
+	// The record which returned an error is delivered again
+	tailer, err = statfile.NewTailer(opts)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	seen = nil
+	err = tailer.Run(ctx, func(rec statfile.Record) error {
+		seen = append(seen, rec.Fields["PNUM"])
+		if len(seen) == 2 {
+			cancel()
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []string{"2", "3"}, seen)
+	require.Equal(t, statfile.Offset{File: "S20260203.002", Position: int64(len(record(3))), Line: 1}, tailer.Offset())
+}
+
+func TestTailer_Backpressure(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "S20260203.001")
+	appendLines(t, path, record(1), record(2), record(3))
+
+	tailer, err := statfile.NewTailer(statfile.TailOptions{
+		Dir:          dir,
+		PollInterval: 10 * time.Millisecond,
+		FromStart:    true,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	records := tailer.Records(ctx)
+
+	require.Equal(t, "1", receive(t, records).Fields["PNUM"])
+
+	// Nothing is read ahead while the consumer is busy
+	cancel()
+	for range records {
+	}
+	require.ErrorIs(t, tailer.Err(), context.Canceled)
+	require.Equal(t, 1, tailer.Offset().Line)
+}
+
+func TestTailer_Errors(t *testing.T) {
+	_, err := statfile.NewTailer(statfile.TailOptions{})
+	require.EqualError(t, err, "missing statistics directory")
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "S20260203.001")
+	appendLines(t, path, "garbage\n")
+
+	tailer, err := statfile.NewTailer(statfile.TailOptions{Dir: dir, FromStart: true})
+	require.NoError(t, err)
+
+	err = tailer.Run(context.Background(), func(statfile.Record) error { return nil })
+	require.EqualError(t, err, path+` line 1: expected KEY=value, found "garbage"`)
+}
@@ -0,0 +1,112 @@
+package userfile
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"text/tabwriter"
+)
+
+// Finding is a permission which is broader than it likely needs to be.
+type Finding struct {
+	Record  string
+	Message string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s", f.Record, f.Message)
+}
+
+// Check flags overly broad permissions, such as proxies which match any remote user and node,
+// map to root, or allow uploads, downloads, tasks or submits without a directory restriction.
+func (u *Userfile) Check() ([]Finding, error) {
+	proxies, err := u.Proxies()
+	if err != nil {
+		return nil, err
+	}
+	users, err := u.LocalUsers()
+	if err != nil {
+		return nil, err
+	}
+
+	var out []Finding
+	for _, p := range proxies {
+		add := func(format string, args ...interface{}) {
+			out = append(out, Finding{Record: p.Name(), Message: fmt.Sprintf(format, args...)})
+		}
+
+		if p.RemoteUser == "*" && p.RemoteNode == "*" {
+			add("matches any remote user on any node")
+		}
+		switch p.LocalID {
+		case "":
+			add("missing local.id")
+		case "root":
+			add("maps to root")
+		}
+
+		for _, perm := range []struct {
+			name    string
+			allowed bool
+			dir     string
+		}{
+			{"upload", p.Upload, p.UploadDir},
+			{"download", p.Download, p.DownloadDir},
+			{"run.task", p.RunTask, p.RunDir},
+			{"submit", p.Submit, p.SubmitDir},
+		} {
+			switch {
+			case !perm.allowed:
+			case perm.dir == "":
+				add("%s is not restricted to a directory", perm.name)
+			case path.Clean(perm.dir) == "/":
+				add("%s is restricted to the root directory", perm.name)
+			}
+		}
+	}
+
+	for _, user := range users {
+		if user.Admin {
+			out = append(out, Finding{Record: user.Name, Message: "has admin.auth"})
+		}
+	}
+	return out, nil
+}
+
+// WriteMatrix writes a table of what each remote proxy is allowed to do, and the directory each
+// permission is limited to.
+//
+//	PROXY              LOCAL ID  UPLOAD          DOWNLOAD         RUN TASK  SUBMIT
+//	fedach@fedach-dit  achuser   /data/inbound   /data/outbound   no        no
+//	*@partner01        achuser   any             no               /opt/...  /opt/...
+func (u *Userfile) WriteMatrix(w io.Writer) error {
+	proxies, err := u.Proxies()
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join([]string{"PROXY", "LOCAL ID", "UPLOAD", "DOWNLOAD", "RUN TASK", "SUBMIT"}, "\t"))
+	for _, p := range proxies {
+		fmt.Fprintln(tw, strings.Join([]string{
+			p.Name(),
+			p.LocalID,
+			permission(p.Upload, p.UploadDir),
+			permission(p.Download, p.DownloadDir),
+			permission(p.RunTask, p.RunDir),
+			permission(p.Submit, p.SubmitDir),
+		}, "\t"))
+	}
+	return tw.Flush()
+}
+
+func permission(allowed bool, dir string) string {
+	switch {
+	case !allowed:
+		return "no"
+	case dir == "":
+		return "any"
+	}
+	return dir
+}
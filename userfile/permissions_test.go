@@ -0,0 +1,44 @@
+package userfile_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+	uf := readUserfile(t)
+
+	findings, err := uf.Check()
+	require.NoError(t, err)
+
+	var lines []string
+	for _, f := range findings {
+		lines = append(lines, f.String())
+	}
+	require.Equal(t, []string{
+		"*@partner01: upload is not restricted to a directory",
+		"*@*: matches any remote user on any node",
+		"*@*: maps to root",
+		"*@*: upload is restricted to the root directory",
+		"*@*: download is not restricted to a directory",
+		"*@*: run.task is not restricted to a directory",
+		"*@*: submit is not restricted to a directory",
+		"cdadmin: has admin.auth",
+	}, lines)
+}
+
+func TestWriteMatrix(t *testing.T) {
+	uf := readUserfile(t)
+
+	var buf strings.Builder
+	require.NoError(t, uf.WriteMatrix(&buf))
+
+	expected := `PROXY              LOCAL ID  UPLOAD         DOWNLOAD        RUN TASK             SUBMIT
+fedach@fedach-dit  achuser   /data/inbound  /data/outbound  no                   no
+*@partner01        achuser   any            no              /opt/cdunix/ndm/bin  /opt/cdunix/ndm/process
+*@*                root      /              any             any                  any
+`
+	require.Equal(t, expected, buf.String())
+}
@@ -0,0 +1,218 @@
+// Package userfile reads Connect:Direct for UNIX userfile.cfg files.
+//
+// userfile.cfg uses the same record format as netmap.cfg. Records named after a local user set what the
+// user can do, and records named "remoteuser@remotenode" map a remote user to a local user as a proxy:
+//
+//	achuser:\
+//	 :cmd.submit=y:\
+//	 :pstats=y:
+//
+//	fedach@fedach-dit:\
+//	 :local.id=achuser:\
+//	 :upload=y:\
+//	 :upload.dir=/data/inbound:
+//
+// An asterisk matches any remote user or node, such as "*@partner01".
+package userfile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/moov-io/go-connect-direct/netmap"
+)
+
+type (
+	Record = netmap.Record
+	Field  = netmap.Field
+)
+
+type Userfile struct {
+	file *netmap.Netmap
+}
+
+// Parse reads a userfile.cfg file.
+func Parse(input string) (*Userfile, error) {
+	file, err := netmap.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing userfile: %w", err)
+	}
+	return &Userfile{file: file}, nil
+}
+
+// Records returns every record in the order they were read.
+func (u *Userfile) Records() []*Record {
+	return u.file.Records
+}
+
+// String returns the userfile as it would be written to userfile.cfg.
+func (u *Userfile) String() string {
+	return u.file.String()
+}
+
+// LocalUser is a record which sets the authority of a local user.
+type LocalUser struct {
+	Name string
+
+	// Admin is true for users with administrative authority (admin.auth)
+	Admin bool
+
+	// ProcessStats allows selecting statistics for processes the user didn't submit (pstats)
+	ProcessStats bool
+
+	// SNodeOverride allows overriding the remote node's user id and password (snode.ovrd)
+	SNodeOverride bool
+
+	// Commands are the cmd.* permissions keyed without the prefix, such as "submit" or "selstats"
+	Commands map[string]bool
+
+	Description string
+	Contact     string
+	Phone       string
+
+	Fields map[string]string
+}
+
+// RemoteProxy maps a remote user on a remote node to a local user.
+type RemoteProxy struct {
+	// RemoteUser and RemoteNode can be "*" to match any user or node
+	RemoteUser string
+	RemoteNode string
+
+	// LocalID is the local user the remote user runs as (local.id)
+	LocalID string
+
+	// Upload, Download, RunTask and Submit are allowed unless set to n, matching Connect:Direct
+	Upload   bool
+	Download bool
+	RunTask  bool
+	Submit   bool
+
+	// Directories which limit each permission. Empty values don't restrict the permission.
+	UploadDir   string
+	DownloadDir string
+	RunDir      string
+	SubmitDir   string
+
+	ProcessStats bool
+	Description  string
+
+	Fields map[string]string
+}
+
+// Name returns the record name, such as "fedach@fedach-dit".
+func (p RemoteProxy) Name() string {
+	return p.RemoteUser + "@" + p.RemoteNode
+}
+
+// isProxy returns true for records named "remoteuser@remotenode"
+func isProxy(rec *Record) bool {
+	return strings.Contains(rec.Name, "@")
+}
+
+func fieldMap(rec *Record) map[string]string {
+	out := make(map[string]string)
+	for _, field := range rec.Fields {
+		if _, exists := out[field.Key]; !exists {
+			out[field.Key] = field.Value
+		}
+	}
+	return out
+}
+
+// LocalUsers returns the records for local users.
+func (u *Userfile) LocalUsers() ([]LocalUser, error) {
+	var out []LocalUser
+	for _, rec := range u.Records() {
+		if isProxy(rec) {
+			continue
+		}
+
+		fields := fieldMap(rec)
+		user := LocalUser{
+			Name:        rec.Name,
+			Commands:    make(map[string]bool),
+			Description: fields["descrip"],
+			Contact:     fields["name"],
+			Phone:       fields["phone"],
+			Fields:      fields,
+		}
+
+		var err error
+		flag := func(key string, dest *bool) {
+			if err == nil {
+				*dest, err = parseFlag(fields, key, false)
+			}
+		}
+		flag("admin.auth", &user.Admin)
+		flag("pstats", &user.ProcessStats)
+		flag("snode.ovrd", &user.SNodeOverride)
+		for _, field := range rec.Fields {
+			if name, found := strings.CutPrefix(field.Key, "cmd."); found && err == nil {
+				var allowed bool
+				flag(field.Key, &allowed)
+				user.Commands[name] = allowed
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("user %s: %w", rec.Name, err)
+		}
+		out = append(out, user)
+	}
+	return out, nil
+}
+
+// Proxies returns the remote user proxy records.
+func (u *Userfile) Proxies() ([]RemoteProxy, error) {
+	var out []RemoteProxy
+	for _, rec := range u.Records() {
+		if !isProxy(rec) {
+			continue
+		}
+
+		fields := fieldMap(rec)
+		user, node, _ := strings.Cut(rec.Name, "@")
+		proxy := RemoteProxy{
+			RemoteUser:  user,
+			RemoteNode:  node,
+			LocalID:     fields["local.id"],
+			UploadDir:   fields["upload.dir"],
+			DownloadDir: fields["download.dir"],
+			RunDir:      fields["run.dir"],
+			SubmitDir:   fields["submit.dir"],
+			Description: fields["descrip"],
+			Fields:      fields,
+		}
+
+		var err error
+		flag := func(key string, dest *bool, def bool) {
+			if err == nil {
+				*dest, err = parseFlag(fields, key, def)
+			}
+		}
+		flag("upload", &proxy.Upload, true)
+		flag("download", &proxy.Download, true)
+		flag("run.task", &proxy.RunTask, true)
+		flag("submit", &proxy.Submit, true)
+		flag("pstats", &proxy.ProcessStats, false)
+		if err != nil {
+			return nil, fmt.Errorf("proxy %s: %w", rec.Name, err)
+		}
+		out = append(out, proxy)
+	}
+	return out, nil
+}
+
+// parseFlag reads a y or n value, returning def when the key is missing or blank
+func parseFlag(fields map[string]string, key string, def bool) (bool, error) {
+	switch value := strings.ToLower(fields[key]); value {
+	case "":
+		return def, nil
+	case "y", "yes":
+		return true, nil
+	case "n", "no":
+		return false, nil
+	default:
+		return false, fmt.Errorf("%s: expected y or n, found %q", key, value)
+	}
+}
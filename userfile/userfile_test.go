@@ -0,0 +1,89 @@
+package userfile_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/go-connect-direct/userfile"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readUserfile(t *testing.T) *userfile.Userfile {
+	t.Helper()
+
+	bs, err := os.ReadFile(filepath.Join("testdata", "userfile.cfg"))
+	require.NoError(t, err)
+
+	uf, err := userfile.Parse(string(bs))
+	require.NoError(t, err)
+	require.Equal(t, string(bs), uf.String())
+
+	return uf
+}
+
+func TestLocalUsers(t *testing.T) {
+	uf := readUserfile(t)
+
+	users, err := uf.LocalUsers()
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+
+	admin := users[0]
+	require.Equal(t, "cdadmin", admin.Name)
+	require.True(t, admin.Admin)
+	require.True(t, admin.SNodeOverride)
+	require.Equal(t, "Connect:Direct administrator", admin.Description)
+
+	ach := users[1]
+	require.False(t, ach.Admin)
+	require.True(t, ach.ProcessStats)
+	require.Equal(t, map[string]bool{"submit": true, "selstats": true, "stopndm": false}, ach.Commands)
+	require.Equal(t, "ACH Operations", ach.Contact)
+	require.Equal(t, "555-0100", ach.Phone)
+}
+
+func TestProxies(t *testing.T) {
+	uf := readUserfile(t)
+
+	proxies, err := uf.Proxies()
+	require.NoError(t, err)
+	require.Len(t, proxies, 3)
+
+	fedach := proxies[0]
+	require.Equal(t, "fedach", fedach.RemoteUser)
+	require.Equal(t, "fedach-dit", fedach.RemoteNode)
+	require.Equal(t, "achuser", fedach.LocalID)
+	require.True(t, fedach.Upload)
+	require.Equal(t, "/data/inbound", fedach.UploadDir)
+	require.True(t, fedach.Download)
+	require.Equal(t, "/data/outbound", fedach.DownloadDir)
+	require.False(t, fedach.RunTask)
+	require.False(t, fedach.Submit)
+
+	partner := proxies[1]
+	require.Equal(t, "*@partner01", partner.Name())
+	require.True(t, partner.Upload)
+	require.False(t, partner.Download)
+	require.True(t, partner.RunTask)
+	require.Equal(t, "/opt/cdunix/ndm/bin", partner.RunDir)
+	require.Equal(t, "/opt/cdunix/ndm/process", partner.SubmitDir)
+}
+
+func TestParse_Errors(t *testing.T) {
+	_, err := userfile.Parse("cdadmin\n")
+	require.EqualError(t, err, `parsing userfile: line 1: expected record name followed by a colon: "cdadmin"`)
+
+	uf, err := userfile.Parse("cdadmin:admin.auth=maybe:\nremote@node:upload=sometimes:\n")
+	require.NoError(t, err)
+
+	_, err = uf.LocalUsers()
+	require.EqualError(t, err, `user cdadmin: admin.auth: expected y or n, found "maybe"`)
+
+	_, err = uf.Proxies()
+	require.EqualError(t, err, `proxy remote@node: upload: expected y or n, found "sometimes"`)
+
+	_, err = uf.Check()
+	require.Error(t, err)
+}